@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bufbuild/connect-go"
 	"github.com/google/uuid"
 	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
 	v1connect "github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // nolint:unused
@@ -22,8 +25,64 @@ var (
 	apiKeyLabelUpdateStrategy string // "replace" or "merge", similar to space implementation
 	// Use the shared labelUpdateStrategy variable from space.go
 	// Commented out to avoid redeclaration: labelUpdateStrategy string
+
+	apiKeyExpiration   string
+	apiKeyExpirationAt string
+
+	apiKeyExpirePrefix      string
+	apiKeyExpireAllMatching bool
+	apiKeyExpireDryRun      bool
+
+	apiKeyPolicyNames []string
+	apiKeyRoleNames   []string
+
+	apiKeyRotateGrace       time.Duration
+	apiKeyRotateCarryLabels bool
+	apiKeyRotateNewLabels   []string
 )
 
+// parseExpirationDuration parses a human-readable TTL like "30m", "24h", or
+// "90d" into a time.Duration. time.ParseDuration already understands
+// h/m/s/ms/us/ns; we extend it to accept a trailing "d" for days, since
+// day-granularity expirations (e.g. "90d") are the common case for API keys.
+func parseExpirationDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		return time.Duration(days * 24 * float64(time.Hour)), nil
+	}
+	return time.ParseDuration(s)
+}
+
+// resolveApiKeyExpiration turns --expiration/--expiration-at into a
+// google.protobuf.Timestamp for CreateApiKeyRequest.ExpiresAt, rejecting
+// anything that has already passed.
+func resolveApiKeyExpiration(expiration, expirationAt string) (*timestamppb.Timestamp, error) {
+	var expiresAt time.Time
+
+	if expirationAt != "" {
+		parsed, err := time.Parse(time.RFC3339, expirationAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --expiration-at %q: %w (expected RFC3339, e.g. 2026-12-31T00:00:00Z)", expirationAt, err)
+		}
+		expiresAt = parsed
+	} else {
+		ttl, err := parseExpirationDuration(expiration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --expiration %q: %w", expiration, err)
+		}
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if expiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("expiration %s is in the past", expiresAt.Format(time.RFC3339))
+	}
+
+	return timestamppb.New(expiresAt), nil
+}
+
 // apikeyCmd represents the apikey command
 var apikeyCmd = &cobra.Command{
 	Use:   "apikey",
@@ -46,7 +105,7 @@ var createApiKeyCmd = &cobra.Command{
 		client := v1connect.NewApiKeyServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse labels from key=value format
@@ -59,15 +118,20 @@ var createApiKeyCmd = &cobra.Command{
 			labelsMap[parts[0]] = parts[1]
 		}
 
+		expiresAt, err := resolveApiKeyExpiration(apiKeyExpiration, apiKeyExpirationAt)
+		if err != nil {
+			return err
+		}
+
 		req := connect.NewRequest(&v1.CreateApiKeyRequest{
-			Labels: labelsMap,
+			Labels:      labelsMap,
+			ExpiresAt:   expiresAt,
+			PolicyNames: apiKeyPolicyNames,
+			RoleNames:   apiKeyRoleNames,
 		})
 
-		// Add API key header from global config
-		if apiKey != "" {
-			req.Header().Set("x-api-key", apiKey)
-		} else {
-			return fmt.Errorf("API key is required. Set it using the --api-key flag or GOODMEM_API_KEY environment variable")
+		if err := addAuthHeader(req); err != nil {
+			return err
 		}
 
 		resp, err := client.CreateApiKey(context.Background(), req)
@@ -139,16 +203,13 @@ var listApiKeysCmd = &cobra.Command{
 		client := v1connect.NewApiKeyServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		req := connect.NewRequest(&v1.ListApiKeysRequest{})
 
-		// Add API key header from global config
-		if apiKey != "" {
-			req.Header().Set("x-api-key", apiKey)
-		} else {
-			return fmt.Errorf("API key is required. Set it using the --api-key flag or GOODMEM_API_KEY environment variable")
+		if err := addAuthHeader(req); err != nil {
+			return err
 		}
 
 		resp, err := client.ListApiKeys(context.Background(), req)
@@ -246,7 +307,7 @@ var updateApiKeyCmd = &cobra.Command{
 		client := v1connect.NewApiKeyServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse labels from key=value format
@@ -309,13 +370,20 @@ var updateApiKeyCmd = &cobra.Command{
 			}
 		}
 
+		// --policy/--role on update attach the named policies/roles in
+		// addition to whatever the key already has; use 'apikey detach' to
+		// remove one.
+		if len(apiKeyPolicyNames) > 0 {
+			updateReq.AttachPolicyNames = apiKeyPolicyNames
+		}
+		if len(apiKeyRoleNames) > 0 {
+			updateReq.AttachRoleNames = apiKeyRoleNames
+		}
+
 		req := connect.NewRequest(updateReq)
 
-		// Add API key header from global config
-		if apiKey != "" {
-			req.Header().Set("x-api-key", apiKey)
-		} else {
-			return fmt.Errorf("API key is required. Set it using the --api-key flag or GOODMEM_API_KEY environment variable")
+		if err := addAuthHeader(req); err != nil {
+			return err
 		}
 
 		resp, err := client.UpdateApiKey(context.Background(), req)
@@ -387,7 +455,7 @@ var deleteApiKeyCmd = &cobra.Command{
 		client := v1connect.NewApiKeyServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse API key ID as UUID
@@ -406,11 +474,8 @@ var deleteApiKeyCmd = &cobra.Command{
 			ApiKeyId: keyBytes,
 		})
 
-		// Add API key header from global config
-		if apiKey != "" {
-			req.Header().Set("x-api-key", apiKey)
-		} else {
-			return fmt.Errorf("API key is required. Set it using the --api-key flag or GOODMEM_API_KEY environment variable")
+		if err := addAuthHeader(req); err != nil {
+			return err
 		}
 
 		_, err = client.DeleteApiKey(context.Background(), req)
@@ -427,6 +492,205 @@ var deleteApiKeyCmd = &cobra.Command{
 	},
 }
 
+// expireApiKeyCmd represents the expire command
+var expireApiKeyCmd = &cobra.Command{
+	Use:   "expire",
+	Short: "Expire an API key by its prefix",
+	Long: `Revokes an API key identified by its KeyPrefix (as shown by 'apikey list'
+or 'apikey create'), without needing the full API key ID. The CLI lists
+the caller's keys from the server and matches the prefix locally; each
+matching key is then revoked by ID, setting its status to INACTIVE and its
+expiration to now.`,
+	Example: `  # Revoke the single key matching a prefix
+  goodmem apikey expire --prefix gm_a1b2c3
+
+  # Preview which keys a prefix would match, without revoking anything
+  goodmem apikey expire --prefix gm_a1b2c3 --dry-run
+
+  # Revoke every key sharing a prefix
+  goodmem apikey expire --prefix gm_a1b2c3 --all-matching`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		if apiKeyExpirePrefix == "" {
+			return fmt.Errorf("--prefix is required")
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(
+			httpClient,
+			serverAddress,
+			connectClientOptions()...,
+		)
+
+		listReq := connect.NewRequest(&v1.ListApiKeysRequest{})
+		if err := addAuthHeader(listReq); err != nil {
+			return err
+		}
+
+		listResp, err := client.ListApiKeys(context.Background(), listReq)
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return fmt.Errorf("%v", connectErr.Message())
+			}
+			return fmt.Errorf("unexpected error: %w", err)
+		}
+
+		var matches []*v1.ApiKeyMetadata
+		for _, key := range listResp.Msg.Keys {
+			if strings.HasPrefix(key.KeyPrefix, apiKeyExpirePrefix) {
+				matches = append(matches, key)
+			}
+		}
+
+		if len(matches) == 0 {
+			return fmt.Errorf("no API key found with prefix %q", apiKeyExpirePrefix)
+		}
+		if len(matches) > 1 && !apiKeyExpireAllMatching {
+			return fmt.Errorf("prefix %q matches %d API keys; pass --all-matching to expire all of them, or use a longer prefix", apiKeyExpirePrefix, len(matches))
+		}
+
+		for _, match := range matches {
+			fmt.Printf("%s (prefix %s)\n", formatUUID(match.ApiKeyId), match.KeyPrefix)
+
+			if apiKeyExpireDryRun {
+				continue
+			}
+
+			status := v1.Status_INACTIVE
+			updateReq := connect.NewRequest(&v1.UpdateApiKeyRequest{
+				ApiKeyId:  match.ApiKeyId,
+				Status:    &status,
+				ExpiresAt: timestamppb.Now(),
+			})
+			if err := addAuthHeader(updateReq); err != nil {
+				return err
+			}
+
+			if _, err := client.UpdateApiKey(context.Background(), updateReq); err != nil {
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) {
+					return fmt.Errorf("failed to expire %s: %v", formatUUID(match.ApiKeyId), connectErr.Message())
+				}
+				return fmt.Errorf("failed to expire %s: %w", formatUUID(match.ApiKeyId), err)
+			}
+		}
+
+		if apiKeyExpireDryRun {
+			fmt.Printf("\nDry run: %d matching key(s) would be expired\n", len(matches))
+		} else {
+			fmt.Printf("\n%d API key(s) expired successfully\n", len(matches))
+		}
+
+		return nil
+	},
+}
+
+// rotateApiKeyCmd represents the rotate command
+var rotateApiKeyCmd = &cobra.Command{
+	Use:   "rotate [api-key-id]",
+	Short: "Issue a replacement API key and retire the old one",
+	Long: `Creates a new API key that inherits the original's labels (unless
+--carry-labels=false) and its expiration time, then retires the old key by
+setting its expiration to now + --grace (default 24h) so in-flight clients
+keep working during rollout. Policies and roles attached to the original
+key are not copied to the new key.`,
+	Example: `  # Rotate a key with the default 24h grace period
+  goodmem apikey rotate 123e4567-e89b-12d3-a456-426614174000
+
+  # Rotate immediately, without carrying over labels
+  goodmem apikey rotate 123e4567-e89b-12d3-a456-426614174000 --grace 0s --carry-labels=false
+
+  # Rotate and add/override a label on the successor
+  goodmem apikey rotate 123e4567-e89b-12d3-a456-426614174000 --new-label environment=production`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		oldKeyID, err := uuidStringToBytes(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid API key ID: %w", err)
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		listReq := connect.NewRequest(&v1.ListApiKeysRequest{})
+		if err := addAuthHeader(listReq); err != nil {
+			return err
+		}
+		listResp, err := client.ListApiKeys(context.Background(), listReq)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		var oldKey *v1.ApiKeyMetadata
+		for _, key := range listResp.Msg.Keys {
+			if uuidBytesEqual(key.ApiKeyId, oldKeyID) {
+				oldKey = key
+				break
+			}
+		}
+		if oldKey == nil {
+			return fmt.Errorf("no API key found with ID %s", args[0])
+		}
+
+		newLabels := make(map[string]string)
+		if apiKeyRotateCarryLabels {
+			for k, v := range oldKey.Labels {
+				newLabels[k] = v
+			}
+		}
+		overrideLabels, err := parseLabels(apiKeyRotateNewLabels)
+		if err != nil {
+			return err
+		}
+		for k, v := range overrideLabels {
+			newLabels[k] = v
+		}
+
+		createReq := connect.NewRequest(&v1.CreateApiKeyRequest{
+			Labels:    newLabels,
+			ExpiresAt: oldKey.ExpiresAt,
+		})
+		if err := addAuthHeader(createReq); err != nil {
+			return err
+		}
+		createResp, err := client.CreateApiKey(context.Background(), createReq)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		graceExpiresAt := timestamppb.New(time.Now().Add(apiKeyRotateGrace))
+		status := v1.Status_INACTIVE
+		if apiKeyRotateGrace > 0 {
+			// Keep the old key ACTIVE during the grace window so in-flight
+			// clients don't immediately start failing; expires_at alone
+			// retires it once the grace period elapses.
+			status = v1.Status_ACTIVE
+		}
+		updateReq := connect.NewRequest(&v1.UpdateApiKeyRequest{
+			ApiKeyId:  oldKeyID,
+			Status:    &status,
+			ExpiresAt: graceExpiresAt,
+		})
+		if err := addAuthHeader(updateReq); err != nil {
+			return err
+		}
+		if _, err := client.UpdateApiKey(context.Background(), updateReq); err != nil {
+			return unwrapConnectError(err)
+		}
+
+		fmt.Printf("New API Key:  %s\n", formatUUID(createResp.Msg.ApiKeyMetadata.ApiKeyId))
+		fmt.Printf("Raw API Key:  %s\n", createResp.Msg.RawApiKey)
+		fmt.Printf("Old key %s retired, expiring at %s\n", args[0], formatTimestamp(graceExpiresAt))
+		fmt.Println("\nIMPORTANT: Save the raw API key value. It will not be shown again.")
+
+		return nil
+	},
+}
+
 
 func init() {
 	rootCmd.AddCommand(apikeyCmd)
@@ -434,15 +698,33 @@ func init() {
 	apikeyCmd.AddCommand(listApiKeysCmd)
 	apikeyCmd.AddCommand(updateApiKeyCmd)
 	apikeyCmd.AddCommand(deleteApiKeyCmd)
+	apikeyCmd.AddCommand(expireApiKeyCmd)
+	apikeyCmd.AddCommand(rotateApiKeyCmd)
 
 	// Common flags for all commands
 	apikeyCmd.PersistentFlags().StringVar(&apikeyOutputFormat, "output", "table", "Output format: table, json, or simple")
 
 	// Flags for create
 	createApiKeyCmd.Flags().StringSliceVar(&apiKeyLabels, "label", []string{}, "Labels in key=value format (can be specified multiple times)")
+	createApiKeyCmd.Flags().StringVarP(&apiKeyExpiration, "expiration", "e", "90d", "Key expiration as a human-readable duration (e.g. 30m, 24h, 90d)")
+	createApiKeyCmd.Flags().StringVar(&apiKeyExpirationAt, "expiration-at", "", "Absolute key expiration as an RFC3339 timestamp (overrides --expiration)")
+	createApiKeyCmd.Flags().StringSliceVar(&apiKeyPolicyNames, "policy", []string{}, "Policy name to attach to the key (can be specified multiple times)")
+	createApiKeyCmd.Flags().StringSliceVar(&apiKeyRoleNames, "role", []string{}, "Role name to attach to the key (can be specified multiple times)")
+
+	// Flags for expire
+	expireApiKeyCmd.Flags().StringVar(&apiKeyExpirePrefix, "prefix", "", "Key prefix to resolve and expire (required)")
+	expireApiKeyCmd.Flags().BoolVar(&apiKeyExpireAllMatching, "all-matching", false, "Expire every API key sharing the given prefix, instead of requiring a unique match")
+	expireApiKeyCmd.Flags().BoolVar(&apiKeyExpireDryRun, "dry-run", false, "Only show which API keys would be expired, without revoking them")
+
+	// Flags for rotate
+	rotateApiKeyCmd.Flags().DurationVar(&apiKeyRotateGrace, "grace", 24*time.Hour, "How long the old key stays valid after rotation, so in-flight clients keep working")
+	rotateApiKeyCmd.Flags().BoolVar(&apiKeyRotateCarryLabels, "carry-labels", true, "Carry the old key's labels over to the new key")
+	rotateApiKeyCmd.Flags().StringSliceVar(&apiKeyRotateNewLabels, "new-label", []string{}, "Label in key=value format to add or override on the successor key (can be specified multiple times)")
 
 	// Flags for update
 	updateApiKeyCmd.Flags().StringVar(&keyStatus, "status", "", "Status of the API key (ACTIVE or INACTIVE)")
 	updateApiKeyCmd.Flags().StringSliceVar(&apiKeyLabels, "label", []string{}, "Labels in key=value format (can be specified multiple times)")
 	updateApiKeyCmd.Flags().StringVar(&labelUpdateStrategy, "label-strategy", "replace", "Label update strategy: 'replace' to overwrite all existing labels, 'merge' to add to existing labels")
+	updateApiKeyCmd.Flags().StringSliceVar(&apiKeyPolicyNames, "policy", []string{}, "Policy name to attach to the key (can be specified multiple times)")
+	updateApiKeyCmd.Flags().StringSliceVar(&apiKeyRoleNames, "role", []string{}, "Role name to attach to the key (can be specified multiple times)")
 }
\ No newline at end of file
@@ -0,0 +1,435 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+	v1connect "github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
+	"github.com/spf13/cobra"
+)
+
+// This file implements the policy/role ACL model attached to API keys,
+// modeled on Consul's policy/role/token hierarchy: a Policy is a named
+// bundle of rules (e.g. "space:read"), a Role is a named bundle of
+// policies, and an API key can attach any number of policies and roles
+// directly. Authorization itself is enforced server-side; the CLI here
+// only manages the resources and their attachment to keys.
+
+var (
+	policyName        string
+	policyDescription string
+	policyRules        []string
+	policyLabelSelectors []string
+
+	roleName          string
+	roleDescription   string
+	rolePolicyNames   []string
+
+	attachPolicyNames []string
+	attachRoleNames   []string
+)
+
+// apikeyPolicyCmd represents the apikey policy command group
+var apikeyPolicyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "Manage API key authorization policies",
+	Long:  `Create, list, update, and delete the named policies that can be attached to API keys and roles.`,
+}
+
+var createPolicyCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new policy",
+	Long:  `Create a new authorization policy from a set of rules (e.g. space:read, memory:write), optionally scoped by label selectors.`,
+	Example: `  goodmem apikey policy create --name readonly --rule space:read --rule memory:read
+  goodmem apikey policy create --name team-a-writer --rule memory:write --label team=a`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		if policyName == "" {
+			return fmt.Errorf("--name is required")
+		}
+
+		labelsMap, err := parseLabels(policyLabelSelectors)
+		if err != nil {
+			return err
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		req := connect.NewRequest(&v1.CreatePolicyRequest{
+			Name:           policyName,
+			Description:    policyDescription,
+			Rules:          policyRules,
+			LabelSelectors: labelsMap,
+		})
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		resp, err := client.CreatePolicy(context.Background(), req)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		fmt.Printf("Policy created: %s (%s)\n", resp.Msg.Name, formatUUID(resp.Msg.PolicyId))
+		return nil
+	},
+}
+
+var listPolicyCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List authorization policies",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		req := connect.NewRequest(&v1.ListPoliciesRequest{})
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		resp, err := client.ListPolicies(context.Background(), req)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		if len(resp.Msg.Policies) == 0 {
+			fmt.Println("No policies found")
+			return nil
+		}
+
+		fmt.Printf("%-36s %-20s %s\n", "POLICY ID", "NAME", "RULES")
+		fmt.Println(strings.Repeat("-", 90))
+		for _, policy := range resp.Msg.Policies {
+			fmt.Printf("%-36s %-20s %s\n", formatUUID(policy.PolicyId), policy.Name, strings.Join(policy.Rules, ", "))
+		}
+		return nil
+	},
+}
+
+var updatePolicyCmd = &cobra.Command{
+	Use:   "update [policy-id]",
+	Short: "Update an authorization policy",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		policyID, err := uuidStringToBytes(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid policy ID: %w", err)
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		updateReq := &v1.UpdatePolicyRequest{PolicyId: policyID}
+		if cmd.Flags().Changed("description") {
+			updateReq.Description = &policyDescription
+		}
+		if cmd.Flags().Changed("rule") {
+			updateReq.Rules = policyRules
+		}
+
+		req := connect.NewRequest(updateReq)
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		resp, err := client.UpdatePolicy(context.Background(), req)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		fmt.Printf("Policy updated: %s (%s)\n", resp.Msg.Name, formatUUID(resp.Msg.PolicyId))
+		return nil
+	},
+}
+
+var deletePolicyCmd = &cobra.Command{
+	Use:   "delete [policy-id]",
+	Short: "Delete an authorization policy",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		policyID, err := uuidStringToBytes(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid policy ID: %w", err)
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		req := connect.NewRequest(&v1.DeletePolicyRequest{PolicyId: policyID})
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		if _, err := client.DeletePolicy(context.Background(), req); err != nil {
+			return unwrapConnectError(err)
+		}
+
+		fmt.Printf("Policy %s deleted successfully\n", args[0])
+		return nil
+	},
+}
+
+// apikeyRoleCmd represents the apikey role command group
+var apikeyRoleCmd = &cobra.Command{
+	Use:   "role",
+	Short: "Manage API key authorization roles",
+	Long:  `Create, list, update, and delete the named roles (bundles of policies) that can be attached to API keys.`,
+}
+
+var createRoleCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new role",
+	Long:  `Create a new role from a set of existing policies.`,
+	Example: `  goodmem apikey role create --name developer --policy readonly --policy team-a-writer`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		if roleName == "" {
+			return fmt.Errorf("--name is required")
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		req := connect.NewRequest(&v1.CreateRoleRequest{
+			Name:        roleName,
+			Description: roleDescription,
+			PolicyNames: rolePolicyNames,
+		})
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		resp, err := client.CreateRole(context.Background(), req)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		fmt.Printf("Role created: %s (%s)\n", resp.Msg.Name, formatUUID(resp.Msg.RoleId))
+		return nil
+	},
+}
+
+var listRoleCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List authorization roles",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		req := connect.NewRequest(&v1.ListRolesRequest{})
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		resp, err := client.ListRoles(context.Background(), req)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		if len(resp.Msg.Roles) == 0 {
+			fmt.Println("No roles found")
+			return nil
+		}
+
+		fmt.Printf("%-36s %-20s %s\n", "ROLE ID", "NAME", "POLICIES")
+		fmt.Println(strings.Repeat("-", 90))
+		for _, role := range resp.Msg.Roles {
+			fmt.Printf("%-36s %-20s %s\n", formatUUID(role.RoleId), role.Name, strings.Join(role.PolicyNames, ", "))
+		}
+		return nil
+	},
+}
+
+var updateRoleCmd = &cobra.Command{
+	Use:   "update [role-id]",
+	Short: "Update an authorization role",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		roleID, err := uuidStringToBytes(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid role ID: %w", err)
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		updateReq := &v1.UpdateRoleRequest{RoleId: roleID}
+		if cmd.Flags().Changed("description") {
+			updateReq.Description = &roleDescription
+		}
+		if cmd.Flags().Changed("policy") {
+			updateReq.PolicyNames = rolePolicyNames
+		}
+
+		req := connect.NewRequest(updateReq)
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		resp, err := client.UpdateRole(context.Background(), req)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		fmt.Printf("Role updated: %s (%s)\n", resp.Msg.Name, formatUUID(resp.Msg.RoleId))
+		return nil
+	},
+}
+
+var deleteRoleCmd = &cobra.Command{
+	Use:   "delete [role-id]",
+	Short: "Delete an authorization role",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		roleID, err := uuidStringToBytes(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid role ID: %w", err)
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		req := connect.NewRequest(&v1.DeleteRoleRequest{RoleId: roleID})
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		if _, err := client.DeleteRole(context.Background(), req); err != nil {
+			return unwrapConnectError(err)
+		}
+
+		fmt.Printf("Role %s deleted successfully\n", args[0])
+		return nil
+	},
+}
+
+// attachApiKeyCmd attaches policies and/or roles to an existing API key.
+var attachApiKeyCmd = &cobra.Command{
+	Use:   "attach [api-key-id]",
+	Short: "Attach policies and/or roles to an API key",
+	Example: `  goodmem apikey attach 123e4567-e89b-12d3-a456-426614174000 --policy readonly --role developer`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateApiKeyAttachments(cmd, args[0], attachPolicyNames, attachRoleNames, true)
+	},
+}
+
+// detachApiKeyCmd is the inverse of attach.
+var detachApiKeyCmd = &cobra.Command{
+	Use:   "detach [api-key-id]",
+	Short: "Detach policies and/or roles from an API key",
+	Example: `  goodmem apikey detach 123e4567-e89b-12d3-a456-426614174000 --policy readonly`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateApiKeyAttachments(cmd, args[0], attachPolicyNames, attachRoleNames, false)
+	},
+}
+
+func updateApiKeyAttachments(cmd *cobra.Command, apiKeyIDStr string, policies, roles []string, attach bool) error {
+	cmd.SilenceUsage = true
+
+	if len(policies) == 0 && len(roles) == 0 {
+		return fmt.Errorf("at least one --policy or --role is required")
+	}
+
+	keyID, err := uuidStringToBytes(apiKeyIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid API key ID: %w", err)
+	}
+
+	httpClient := createHTTPClient(true, serverAddress)
+	client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+	updateReq := &v1.UpdateApiKeyRequest{ApiKeyId: keyID}
+	if attach {
+		updateReq.AttachPolicyNames = policies
+		updateReq.AttachRoleNames = roles
+	} else {
+		updateReq.DetachPolicyNames = policies
+		updateReq.DetachRoleNames = roles
+	}
+
+	req := connect.NewRequest(updateReq)
+	if err := addAuthHeader(req); err != nil {
+		return err
+	}
+
+	resp, err := client.UpdateApiKey(context.Background(), req)
+	if err != nil {
+		return unwrapConnectError(err)
+	}
+
+	verb := "attached to"
+	if !attach {
+		verb = "detached from"
+	}
+	fmt.Printf("Policies/roles %s API key %s\n", verb, formatUUID(resp.Msg.ApiKeyId))
+	return nil
+}
+
+// unwrapConnectError turns a connect.Error into a plain error with just its
+// message, matching the rest of apikey.go's error reporting.
+func unwrapConnectError(err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return fmt.Errorf("%v", connectErr.Message())
+	}
+	return fmt.Errorf("unexpected error: %w", err)
+}
+
+func init() {
+	apikeyCmd.AddCommand(apikeyPolicyCmd)
+	apikeyPolicyCmd.AddCommand(createPolicyCmd)
+	apikeyPolicyCmd.AddCommand(listPolicyCmd)
+	apikeyPolicyCmd.AddCommand(updatePolicyCmd)
+	apikeyPolicyCmd.AddCommand(deletePolicyCmd)
+
+	apikeyCmd.AddCommand(apikeyRoleCmd)
+	apikeyRoleCmd.AddCommand(createRoleCmd)
+	apikeyRoleCmd.AddCommand(listRoleCmd)
+	apikeyRoleCmd.AddCommand(updateRoleCmd)
+	apikeyRoleCmd.AddCommand(deleteRoleCmd)
+
+	apikeyCmd.AddCommand(attachApiKeyCmd)
+	apikeyCmd.AddCommand(detachApiKeyCmd)
+
+	createPolicyCmd.Flags().StringVar(&policyName, "name", "", "Policy name (required)")
+	createPolicyCmd.Flags().StringVar(&policyDescription, "description", "", "Policy description")
+	createPolicyCmd.Flags().StringSliceVar(&policyRules, "rule", []string{}, "Rule granting an operation, e.g. space:read (can be specified multiple times)")
+	createPolicyCmd.Flags().StringSliceVar(&policyLabelSelectors, "label", []string{}, "Label selector in key=value format scoping the rules (can be specified multiple times)")
+
+	updatePolicyCmd.Flags().StringVar(&policyDescription, "description", "", "Policy description")
+	updatePolicyCmd.Flags().StringSliceVar(&policyRules, "rule", []string{}, "Rule granting an operation, e.g. space:read (can be specified multiple times)")
+
+	createRoleCmd.Flags().StringVar(&roleName, "name", "", "Role name (required)")
+	createRoleCmd.Flags().StringVar(&roleDescription, "description", "", "Role description")
+	createRoleCmd.Flags().StringSliceVar(&rolePolicyNames, "policy", []string{}, "Policy name to include in the role (can be specified multiple times)")
+
+	updateRoleCmd.Flags().StringVar(&roleDescription, "description", "", "Role description")
+	updateRoleCmd.Flags().StringSliceVar(&rolePolicyNames, "policy", []string{}, "Policy name to include in the role (can be specified multiple times)")
+
+	attachApiKeyCmd.Flags().StringSliceVar(&attachPolicyNames, "policy", []string{}, "Policy name to attach (can be specified multiple times)")
+	attachApiKeyCmd.Flags().StringSliceVar(&attachRoleNames, "role", []string{}, "Role name to attach (can be specified multiple times)")
+
+	detachApiKeyCmd.Flags().StringSliceVar(&attachPolicyNames, "policy", []string{}, "Policy name to detach (can be specified multiple times)")
+	detachApiKeyCmd.Flags().StringSliceVar(&attachRoleNames, "role", []string{}, "Role name to detach (can be specified multiple times)")
+}
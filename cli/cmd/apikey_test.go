@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseExpirationDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "hours", input: "24h", want: 24 * time.Hour},
+		{name: "minutes", input: "30m", want: 30 * time.Minute},
+		{name: "days", input: "90d", want: 90 * 24 * time.Hour},
+		{name: "fractional days", input: "1.5d", want: 36 * time.Hour},
+		{name: "invalid day count", input: "xd", wantErr: true},
+		{name: "invalid duration", input: "not-a-duration", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseExpirationDuration(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseExpirationDuration(%q): expected error, got nil", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseExpirationDuration(%q): unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseExpirationDuration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveApiKeyExpiration(t *testing.T) {
+	t.Run("expiration-at in the future", func(t *testing.T) {
+		future := time.Now().Add(48 * time.Hour).Format(time.RFC3339)
+		ts, err := resolveApiKeyExpiration("", future)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ts.AsTime().After(time.Now()) {
+			t.Errorf("expected resolved timestamp to be in the future, got %v", ts.AsTime())
+		}
+	})
+
+	t.Run("expiration-at in the past is rejected", func(t *testing.T) {
+		past := time.Now().Add(-48 * time.Hour).Format(time.RFC3339)
+		if _, err := resolveApiKeyExpiration("", past); err == nil {
+			t.Fatal("expected error for past --expiration-at, got nil")
+		}
+	})
+
+	t.Run("expiration TTL resolves relative to now", func(t *testing.T) {
+		ts, err := resolveApiKeyExpiration("24h", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ts.AsTime().After(time.Now()) {
+			t.Errorf("expected resolved timestamp to be in the future, got %v", ts.AsTime())
+		}
+	})
+
+	t.Run("invalid expiration-at format", func(t *testing.T) {
+		if _, err := resolveApiKeyExpiration("", "not-a-timestamp"); err == nil {
+			t.Fatal("expected error for malformed --expiration-at, got nil")
+		}
+	})
+
+	t.Run("invalid expiration duration", func(t *testing.T) {
+		if _, err := resolveApiKeyExpiration("not-a-duration", ""); err == nil {
+			t.Fatal("expected error for malformed --expiration, got nil")
+		}
+	})
+
+	t.Run("expiration-at takes precedence over expiration", func(t *testing.T) {
+		future := time.Now().Add(2 * time.Hour).Format(time.RFC3339)
+		ts, err := resolveApiKeyExpiration("not-a-duration", future)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ts.AsTime().After(time.Now()) {
+			t.Errorf("expected resolved timestamp to be in the future, got %v", ts.AsTime())
+		}
+	})
+}
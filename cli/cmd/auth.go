@@ -0,0 +1,428 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+	"github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
+	"github.com/spf13/cobra"
+)
+
+var (
+	authModeFlag   string
+	oidcIssuer     string
+	oidcClientID   string
+	oidcScopes     []string
+	basicUsername  string
+	basicPassword  string
+)
+
+// authCmd groups the login/logout/whoami commands used to manage
+// credentials for the non-apikey auth modes.
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Manage GoodMem authentication credentials",
+	Long:  `Log in, log out, and inspect the identity used for OIDC/basic auth modes.`,
+}
+
+// authLoginCmd runs an OAuth2 device-code flow against the configured
+// OIDC issuer and persists the resulting refresh token, encrypted at rest.
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Authenticate via the configured OIDC issuer",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if oidcIssuer == "" {
+			return fmt.Errorf("--oidc-issuer is required for auth login")
+		}
+		if oidcClientID == "" {
+			return fmt.Errorf("--oidc-client-id is required for auth login")
+		}
+		cmd.SilenceUsage = true
+
+		device, err := startDeviceAuthorization(oidcIssuer, oidcClientID, oidcScopes)
+		if err != nil {
+			return fmt.Errorf("error starting device authorization: %w", err)
+		}
+
+		fmt.Printf("To sign in, visit %s and enter code %s\n", device.VerificationURI, device.UserCode)
+
+		tokens, err := pollDeviceToken(oidcIssuer, oidcClientID, device)
+		if err != nil {
+			return fmt.Errorf("error completing login: %w", err)
+		}
+
+		cfgDir, cfgPath := defaultConfigPaths()
+		if err := os.MkdirAll(cfgDir, 0755); err != nil {
+			return fmt.Errorf("error creating config directory: %w", err)
+		}
+
+		key, err := loadOrCreateEncryptionKey(cfgDir)
+		if err != nil {
+			return fmt.Errorf("error preparing credential storage: %w", err)
+		}
+
+		encRefresh, err := encryptString(key, tokens.RefreshToken)
+		if err != nil {
+			return fmt.Errorf("error encrypting refresh token: %w", err)
+		}
+
+		cfg, _ := readConfigFile(cfgPath)
+		cfg.AuthMode = "oidc"
+		cfg.OIDCIssuer = oidcIssuer
+		cfg.OIDCClientID = oidcClientID
+		cfg.OIDCScopes = oidcScopes
+		cfg.OIDCRefreshToken = encRefresh
+		if err := writeConfigFile(cfgPath, cfg); err != nil {
+			return fmt.Errorf("error saving config file: %w", err)
+		}
+		_ = os.Chmod(cfgPath, 0600)
+
+		fmt.Println("Login successful. Refresh token stored under ~/.goodmem (mode 0600).")
+		return nil
+	},
+}
+
+// authLogoutCmd clears any stored OIDC credentials from the config file.
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Clear stored OIDC credentials",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, cfgPath := defaultConfigPaths()
+		cfg, err := readConfigFile(cfgPath)
+		if err != nil {
+			fmt.Println("No stored credentials found.")
+			return nil
+		}
+		cfg.AuthMode = "apikey"
+		cfg.OIDCRefreshToken = ""
+		if err := writeConfigFile(cfgPath, cfg); err != nil {
+			return fmt.Errorf("error saving config file: %w", err)
+		}
+		fmt.Println("Logged out.")
+		return nil
+	},
+}
+
+// authWhoamiCmd resolves the effective auth mode and prints the identity
+// the server associates with the current credentials.
+var authWhoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Show the identity associated with the current credentials",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewUserServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		req := connect.NewRequest(&v1.GetUserRequest{})
+		if err := applyAuth(req); err != nil {
+			return err
+		}
+
+		resp, err := client.GetUser(context.Background(), req)
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return fmt.Errorf("%v", connectErr.Message())
+			}
+			return fmt.Errorf("unexpected error: %w", err)
+		}
+
+		jsonBytes, err := formatProtoMessageAsJSON(resp.Msg)
+		if err != nil {
+			return fmt.Errorf("error formatting response as JSON: %w", err)
+		}
+		fmt.Println(string(jsonBytes))
+		return nil
+	},
+}
+
+// applyAuth injects whichever authentication mode is configured onto a
+// connect request. This is the single place that decides between a static
+// API key, an OIDC bearer token, and HTTP basic auth, so every subcommand
+// picks up the configured mode without its own header-setting logic.
+func applyAuth(req connect.AnyRequest) error {
+	switch effectiveAuthMode() {
+	case "oidc":
+		token, err := globalTokenSource().AccessToken()
+		if err != nil {
+			return fmt.Errorf("error refreshing OIDC access token: %w", err)
+		}
+		req.Header().Set("Authorization", "Bearer "+token)
+		return nil
+	case "basic":
+		if basicUsername == "" {
+			return fmt.Errorf("--basic-username is required for --auth-mode basic")
+		}
+		creds := base64.StdEncoding.EncodeToString([]byte(basicUsername + ":" + basicPassword))
+		req.Header().Set("Authorization", "Basic "+creds)
+		return nil
+	default:
+		if apiKey == "" {
+			return fmt.Errorf("API key is required. Set it using the --api-key flag or GOODMEM_API_KEY environment variable")
+		}
+		req.Header().Set("x-api-key", apiKey)
+		return nil
+	}
+}
+
+// effectiveAuthMode prefers an explicit --auth-mode flag, falling back to
+// whatever `auth login` most recently persisted to the config file.
+func effectiveAuthMode() string {
+	if authModeFlag != "" {
+		return authModeFlag
+	}
+	_, cfgPath := defaultConfigPaths()
+	if cfg, err := readConfigFile(cfgPath); err == nil && cfg.AuthMode != "" {
+		return cfg.AuthMode
+	}
+	return "apikey"
+}
+
+// oidcTokenSource refreshes and caches an OIDC access token in memory for
+// the lifetime of the process.
+type oidcTokenSource struct {
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+var (
+	tokenSourceOnce sync.Once
+	tokenSource     *oidcTokenSource
+)
+
+func globalTokenSource() *oidcTokenSource {
+	tokenSourceOnce.Do(func() { tokenSource = &oidcTokenSource{} })
+	return tokenSource
+}
+
+// AccessToken returns a cached access token, refreshing it via the stored
+// refresh token when it is missing or within 30 seconds of expiring.
+func (t *oidcTokenSource) AccessToken() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.accessToken != "" && time.Now().Add(30*time.Second).Before(t.expiresAt) {
+		return t.accessToken, nil
+	}
+
+	_, cfgPath := defaultConfigPaths()
+	cfg, err := readConfigFile(cfgPath)
+	if err != nil || cfg.OIDCRefreshToken == "" {
+		return "", fmt.Errorf("no stored OIDC credentials; run 'goodmem auth login' first")
+	}
+
+	cfgDir, _ := defaultConfigPaths()
+	key, err := loadOrCreateEncryptionKey(cfgDir)
+	if err != nil {
+		return "", err
+	}
+	refreshToken, err := decryptString(key, cfg.OIDCRefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("error decrypting stored refresh token: %w", err)
+	}
+
+	tokens, err := refreshAccessToken(cfg.OIDCIssuer, cfg.OIDCClientID, refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	t.accessToken = tokens.AccessToken
+	t.expiresAt = time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	return t.accessToken, nil
+}
+
+// --- Minimal OAuth2 device-code + refresh plumbing ---
+
+type deviceAuthorization struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	Interval        int    `json:"interval"`
+	ExpiresIn       int    `json:"expires_in"`
+}
+
+type oidcTokens struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+func startDeviceAuthorization(issuer, clientID string, scopes []string) (*deviceAuthorization, error) {
+	form := url.Values{
+		"client_id": {clientID},
+		"scope":     {strings.Join(scopes, " ")},
+	}
+	resp, err := http.PostForm(strings.TrimRight(issuer, "/")+"/device_authorization", form)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var device deviceAuthorization
+	if err := json.NewDecoder(resp.Body).Decode(&device); err != nil {
+		return nil, fmt.Errorf("error decoding device authorization response: %w", err)
+	}
+	if device.Interval == 0 {
+		device.Interval = 5
+	}
+	return &device, nil
+}
+
+func pollDeviceToken(issuer, clientID string, device *deviceAuthorization) (*oidcTokens, error) {
+	form := url.Values{
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"device_code": {device.DeviceCode},
+		"client_id":   {clientID},
+	}
+
+	deadline := time.Now().Add(time.Duration(device.ExpiresIn) * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := http.PostForm(strings.TrimRight(issuer, "/")+"/token", form)
+		if err != nil {
+			return nil, err
+		}
+
+		var tokens oidcTokens
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode == http.StatusOK {
+			if err := json.Unmarshal(body, &tokens); err != nil {
+				return nil, fmt.Errorf("error decoding token response: %w", err)
+			}
+			return &tokens, nil
+		}
+
+		time.Sleep(time.Duration(device.Interval) * time.Second)
+	}
+	return nil, fmt.Errorf("device code expired before login was completed")
+}
+
+func refreshAccessToken(issuer, clientID, refreshToken string) (*oidcTokens, error) {
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"client_id":     {clientID},
+	}
+	resp, err := http.PostForm(strings.TrimRight(issuer, "/")+"/token", form)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tokens oidcTokens
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return nil, fmt.Errorf("error decoding refresh response: %w", err)
+	}
+	return &tokens, nil
+}
+
+// --- Encryption-at-rest for the stored refresh token ---
+
+func defaultConfigPaths() (dir string, path string) {
+	if configDir != "" {
+		dir = configDir
+	} else if home, err := os.UserHomeDir(); err == nil {
+		dir = filepath.Join(home, ".goodmem")
+	}
+	if configFile != "" {
+		path = configFile
+	} else {
+		path = filepath.Join(dir, "config.json")
+	}
+	return dir, path
+}
+
+func loadOrCreateEncryptionKey(dir string) ([]byte, error) {
+	keyPath := filepath.Join(dir, "oidc.key")
+	if data, err := os.ReadFile(keyPath); err == nil && len(data) == 32 {
+		return data, nil
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(keyPath, key, 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func encryptString(key []byte, plaintext string) (string, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	ciphertext := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func decryptString(key []byte, blob string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(blob)
+	if err != nil {
+		return "", err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimSpace(plaintext)), nil
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authLoginCmd)
+	authCmd.AddCommand(authLogoutCmd)
+	authCmd.AddCommand(authWhoamiCmd)
+
+	rootCmd.PersistentFlags().StringVar(&authModeFlag, "auth-mode", "", "Authentication mode: apikey (default), oidc, or basic")
+	rootCmd.PersistentFlags().StringVar(&basicUsername, "basic-username", "", "Username for --auth-mode basic")
+	rootCmd.PersistentFlags().StringVar(&basicPassword, "basic-password", "", "Password for --auth-mode basic")
+
+	authLoginCmd.Flags().StringVar(&oidcIssuer, "oidc-issuer", "", "OIDC issuer URL")
+	authLoginCmd.Flags().StringVar(&oidcClientID, "oidc-client-id", "", "OIDC client ID")
+	authLoginCmd.Flags().StringSliceVar(&oidcScopes, "oidc-scopes", []string{"openid", "offline_access"}, "OIDC scopes to request")
+}
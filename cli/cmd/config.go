@@ -0,0 +1,374 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// CLIConfig is the kubeconfig-style persistent config file, loaded from
+// $XDG_CONFIG_HOME/goodmem/config.yaml (overridable with --config). It holds
+// multiple named contexts so operators can switch between servers/projects
+// without retyping --server/--api-key/--embedder-id on every invocation.
+type CLIConfig struct {
+	CurrentContext string                 `yaml:"current-context"`
+	Contexts       map[string]*CLIContext `yaml:"contexts"`
+}
+
+// CLIContext bundles the connection details and default flag values for one
+// named context.
+type CLIContext struct {
+	Server            string `yaml:"server,omitempty"`
+	ApiKey            string `yaml:"api_key,omitempty"`
+	ApiKeyCommand     string `yaml:"api_key_command,omitempty"`
+	DefaultEmbedderID string `yaml:"default_embedder_id,omitempty"`
+	DefaultOwner      string `yaml:"default_owner,omitempty"`
+	DefaultFormat     string `yaml:"default_format,omitempty"`
+}
+
+var cliConfigPath string
+
+// defaultCLIConfigPath returns $XDG_CONFIG_HOME/goodmem/config.yaml, falling
+// back to ~/.config/goodmem/config.yaml when XDG_CONFIG_HOME isn't set.
+func defaultCLIConfigPath() (string, error) {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "goodmem", "config.yaml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error getting user home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "goodmem", "config.yaml"), nil
+}
+
+func resolveCLIConfigPath() (string, error) {
+	if cliConfigPath != "" {
+		return cliConfigPath, nil
+	}
+	return defaultCLIConfigPath()
+}
+
+// loadCLIConfig reads the config file, returning an empty (but non-nil)
+// config if it doesn't exist yet.
+func loadCLIConfig() (*CLIConfig, error) {
+	path, err := resolveCLIConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &CLIConfig{Contexts: make(map[string]*CLIContext)}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if cfg.Contexts == nil {
+		cfg.Contexts = make(map[string]*CLIContext)
+	}
+	return cfg, nil
+}
+
+func saveCLIConfig(cfg *CLIConfig) error {
+	path, err := resolveCLIConfigPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("error encoding config: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+// currentContext returns the selected context, or nil if none is configured.
+func (c *CLIConfig) currentContext() *CLIContext {
+	if c.CurrentContext == "" {
+		return nil
+	}
+	return c.Contexts[c.CurrentContext]
+}
+
+// resolveApiKey returns the context's API key, running ApiKeyCommand to
+// retrieve it from a secret manager when ApiKey isn't set directly.
+func (c *CLIContext) resolveApiKey() (string, error) {
+	if c.ApiKey != "" {
+		return c.ApiKey, nil
+	}
+	if c.ApiKeyCommand == "" {
+		return "", nil
+	}
+
+	out, err := exec.Command("sh", "-c", c.ApiKeyCommand).Output()
+	if err != nil {
+		return "", fmt.Errorf("error running api_key_command: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// applyContextDefaults backfills --server/--api-key and, where the invoked
+// command has the corresponding flag, --embedder-id/--owner/--format from
+// the current context, for any flag the user didn't pass explicitly.
+func applyContextDefaults(cmd *cobra.Command) error {
+	cfg, err := loadCLIConfig()
+	if err != nil {
+		return err
+	}
+	ctx := cfg.currentContext()
+	if ctx == nil {
+		return nil
+	}
+
+	flags := cmd.Flags()
+
+	if ctx.Server != "" && !flags.Changed("server") {
+		if err := flags.Set("server", ctx.Server); err != nil {
+			return err
+		}
+	}
+
+	if !flags.Changed("api-key") {
+		key, err := ctx.resolveApiKey()
+		if err != nil {
+			return err
+		}
+		if key != "" {
+			if err := flags.Set("api-key", key); err != nil {
+				return err
+			}
+		}
+	}
+
+	setIfUnchanged := func(name, value string) error {
+		f := flags.Lookup(name)
+		if f == nil || f.Changed || value == "" {
+			return nil
+		}
+		return flags.Set(name, value)
+	}
+
+	if err := setIfUnchanged("embedder-id", ctx.DefaultEmbedderID); err != nil {
+		return err
+	}
+	if err := setIfUnchanged("owner", ctx.DefaultOwner); err != nil {
+		return err
+	}
+	if err := setIfUnchanged("format", ctx.DefaultFormat); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// configCmd represents the config command
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage goodmem CLI contexts",
+	Long:  `View and modify the persistent CLI config file (server, api-key, and defaults, grouped into named contexts), modeled on kubeconfig.`,
+}
+
+var useContextCmd = &cobra.Command{
+	Use:   "use-context <name>",
+	Short: "Set the current context",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		cfg, err := loadCLIConfig()
+		if err != nil {
+			return err
+		}
+		if _, ok := cfg.Contexts[args[0]]; !ok {
+			return fmt.Errorf("no such context: %s (use 'goodmem config set-context' to create it)", args[0])
+		}
+
+		cfg.CurrentContext = args[0]
+		if err := saveCLIConfig(cfg); err != nil {
+			return err
+		}
+
+		fmt.Printf("Switched to context %q\n", args[0])
+		return nil
+	},
+}
+
+var (
+	setContextServer            string
+	setContextApiKey            string
+	setContextApiKeyCommand     string
+	setContextDefaultEmbedderID string
+	setContextDefaultOwner      string
+	setContextDefaultFormat     string
+)
+
+var setContextCmd = &cobra.Command{
+	Use:   "set-context <name>",
+	Short: "Create or update a context",
+	Example: `  goodmem config set-context production --server https://goodmem.example.com:9090 --api-key gm_xxx
+  goodmem config set-context staging --server https://staging.internal:9090 --default-format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		cfg, err := loadCLIConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx, ok := cfg.Contexts[args[0]]
+		if !ok {
+			ctx = &CLIContext{}
+			cfg.Contexts[args[0]] = ctx
+		}
+
+		if cmd.Flags().Changed("server") {
+			ctx.Server = setContextServer
+		}
+		if cmd.Flags().Changed("api-key") {
+			ctx.ApiKey = setContextApiKey
+		}
+		if cmd.Flags().Changed("api-key-command") {
+			ctx.ApiKeyCommand = setContextApiKeyCommand
+		}
+		if cmd.Flags().Changed("default-embedder-id") {
+			ctx.DefaultEmbedderID = setContextDefaultEmbedderID
+		}
+		if cmd.Flags().Changed("default-owner") {
+			ctx.DefaultOwner = setContextDefaultOwner
+		}
+		if cmd.Flags().Changed("default-format") {
+			ctx.DefaultFormat = setContextDefaultFormat
+		}
+
+		if cfg.CurrentContext == "" {
+			cfg.CurrentContext = args[0]
+		}
+
+		if err := saveCLIConfig(cfg); err != nil {
+			return err
+		}
+
+		fmt.Printf("Context %q saved\n", args[0])
+		return nil
+	},
+}
+
+var getContextsCmd = &cobra.Command{
+	Use:   "get-contexts",
+	Short: "List the configured contexts",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		cfg, err := loadCLIConfig()
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(cfg.Contexts))
+		for name := range cfg.Contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		if len(names) == 0 {
+			fmt.Println("No contexts configured")
+			return nil
+		}
+
+		fmt.Printf("%-3s %-20s %s\n", "", "NAME", "SERVER")
+		for _, name := range names {
+			marker := " "
+			if name == cfg.CurrentContext {
+				marker = "*"
+			}
+			fmt.Printf("%-3s %-20s %s\n", marker, name, cfg.Contexts[name].Server)
+		}
+		return nil
+	},
+}
+
+var viewConfigCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Print the resolved config file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		cfg, err := loadCLIConfig()
+		if err != nil {
+			return err
+		}
+
+		data, err := yaml.Marshal(cfg)
+		if err != nil {
+			return fmt.Errorf("error encoding config: %w", err)
+		}
+
+		fmt.Print(string(data))
+		return nil
+	},
+}
+
+var unsetContextCmd = &cobra.Command{
+	Use:   "unset <name>",
+	Short: "Remove a context",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		cfg, err := loadCLIConfig()
+		if err != nil {
+			return err
+		}
+		if _, ok := cfg.Contexts[args[0]]; !ok {
+			return fmt.Errorf("no such context: %s", args[0])
+		}
+
+		delete(cfg.Contexts, args[0])
+		if cfg.CurrentContext == args[0] {
+			cfg.CurrentContext = ""
+		}
+
+		if err := saveCLIConfig(cfg); err != nil {
+			return err
+		}
+
+		fmt.Printf("Context %q removed\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&cliConfigPath, "config", "", "Path to the CLI config file (defaults to $XDG_CONFIG_HOME/goodmem/config.yaml)")
+
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(useContextCmd)
+	configCmd.AddCommand(setContextCmd)
+	configCmd.AddCommand(getContextsCmd)
+	configCmd.AddCommand(viewConfigCmd)
+	configCmd.AddCommand(unsetContextCmd)
+
+	setContextCmd.Flags().StringVar(&setContextServer, "server", "", "GoodMem server address for this context")
+	setContextCmd.Flags().StringVar(&setContextApiKey, "api-key", "", "API key for this context")
+	setContextCmd.Flags().StringVar(&setContextApiKeyCommand, "api-key-command", "", "Shell command to run to retrieve the API key (alternative to --api-key)")
+	setContextCmd.Flags().StringVar(&setContextDefaultEmbedderID, "default-embedder-id", "", "Default embedder ID for 'space create' in this context")
+	setContextCmd.Flags().StringVar(&setContextDefaultOwner, "default-owner", "", "Default owner ID for this context")
+	setContextCmd.Flags().StringVar(&setContextDefaultFormat, "default-format", "", "Default output format for this context")
+}
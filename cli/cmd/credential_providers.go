@@ -0,0 +1,292 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// CredentialProvider resolves an opaque reference (the part of a
+// --credentials-ref value after the "prefix:") into the actual secret value.
+// New backends (Azure Key Vault, 1Password, ...) register themselves in
+// credentialProviders below.
+type CredentialProvider interface {
+	Resolve(ref string) (string, error)
+}
+
+// credentialProviders maps a --credentials-ref prefix to the provider that
+// resolves it, e.g. "env:OPENAI_API_KEY" is looked up under "env".
+var credentialProviders = map[string]CredentialProvider{
+	"env":                envCredentialProvider{},
+	"file":               fileCredentialProvider{},
+	"stdin":              stdinCredentialProvider{},
+	"keyring":            keyringCredentialProvider{},
+	"aws-secretsmanager": awsSecretsManagerCredentialProvider{},
+	"aws-sm":             awsSecretsManagerCredentialProvider{},
+	"gcp-sm":             gcpSecretManagerCredentialProvider{},
+	"azkv":               azureKeyVaultCredentialProvider{},
+	"vault":              vaultCredentialProvider{},
+}
+
+// credentialURISchemes maps a "scheme://rest" prefix (as opposed to the
+// "prefix:rest" form above) to its provider, for reference styles that read
+// more naturally as a URI: azkv://vault-name/secret-name, aws-sm://<arn>,
+// vault://path#field.
+var credentialURISchemes = map[string]CredentialProvider{
+	"azkv":   azureKeyVaultCredentialProvider{},
+	"aws-sm": awsSecretsManagerCredentialProvider{},
+	"vault":  vaultCredentialProvider{},
+}
+
+// credentialProviderPrefixes returns the registered prefixes in a stable
+// order, for shell completion and error messages.
+func credentialProviderPrefixes() []string {
+	prefixes := make([]string, 0, len(credentialProviders))
+	for prefix := range credentialProviders {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+// completeCredentialRefPrefixes offers the registered provider prefixes
+// (env:, file:, ...) as shell completions for --credentials-ref.
+func completeCredentialRefPrefixes(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	suggestions := make([]string, 0, len(credentialProviders))
+	for _, prefix := range credentialProviderPrefixes() {
+		suggestions = append(suggestions, prefix+":")
+	}
+	return suggestions, cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp
+}
+
+// resolveCredentialsRef resolves a "prefix:rest" --credentials-ref value
+// (e.g. "env:OPENAI_API_KEY", "vault:secret/data/openai#key") through the
+// matching CredentialProvider.
+func resolveCredentialsRef(value string) (string, error) {
+	prefix, rest, ok := strings.Cut(value, ":")
+	if !ok {
+		return "", fmt.Errorf("invalid --credentials-ref %q: expected <prefix>:<ref> (supported prefixes: %s)", value, strings.Join(credentialProviderPrefixes(), ", "))
+	}
+
+	provider, ok := credentialProviders[prefix]
+	if !ok {
+		return "", fmt.Errorf("unknown credentials-ref prefix %q (supported prefixes: %s)", prefix, strings.Join(credentialProviderPrefixes(), ", "))
+	}
+
+	resolved, err := provider.Resolve(rest)
+	if err != nil {
+		return "", fmt.Errorf("error resolving %s: %w", value, err)
+	}
+	return resolved, nil
+}
+
+// resolveCredentialValue resolves a --credentials/--credentials-from value
+// that may be a URI-style secret reference (env:VAR, file:/path, stdin:,
+// azkv://vault/secret, aws-sm://<arn>, vault://path#field, ...), client-side,
+// just before the gRPC call. The resolver URI itself is never persisted;
+// only the resolved secret is sent. A value that doesn't match any known
+// reference form is returned unchanged, so plain literal credentials keep
+// working exactly as before.
+func resolveCredentialValue(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	if scheme, rest, ok := strings.Cut(value, "://"); ok {
+		if provider, ok := credentialURISchemes[scheme]; ok {
+			resolved, err := provider.Resolve(rest)
+			if err != nil {
+				return "", fmt.Errorf("error resolving %s: %w", value, err)
+			}
+			return resolved, nil
+		}
+	}
+
+	if prefix, rest, ok := strings.Cut(value, ":"); ok {
+		if provider, ok := credentialProviders[prefix]; ok {
+			resolved, err := provider.Resolve(rest)
+			if err != nil {
+				return "", fmt.Errorf("error resolving %s: %w", value, err)
+			}
+			return resolved, nil
+		}
+	}
+
+	return value, nil
+}
+
+// envCredentialProvider resolves "env:VAR" from the process environment.
+type envCredentialProvider struct{}
+
+func (envCredentialProvider) Resolve(ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", ref)
+	}
+	return value, nil
+}
+
+// fileCredentialProvider resolves "file:/path" by reading the file's
+// contents, trimming trailing whitespace (matches how most secret files are
+// written by operators: one value, possibly with a trailing newline).
+type fileCredentialProvider struct{}
+
+func (fileCredentialProvider) Resolve(ref string) (string, error) {
+	data, err := os.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// stdinCredentialProvider resolves "stdin:" by reading the credential from
+// standard input, for callers that pipe a secret in rather than reference
+// one (ref is ignored; stdin has no address of its own).
+type stdinCredentialProvider struct{}
+
+func (stdinCredentialProvider) Resolve(ref string) (string, error) {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("error reading credential from stdin: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// azureKeyVaultCredentialProvider resolves "azkv://vault-name/secret-name"
+// by shelling out to the Azure CLI, which uses its own ambient credential
+// chain (az login, managed identity, etc.).
+type azureKeyVaultCredentialProvider struct{}
+
+func (azureKeyVaultCredentialProvider) Resolve(ref string) (string, error) {
+	vaultName, secretName, ok := strings.Cut(ref, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid azkv ref %q: expected <vault-name>/<secret-name>", ref)
+	}
+	out, err := exec.Command("az", "keyvault", "secret", "show", "--vault-name", vaultName, "--name", secretName, "--query", "value", "-o", "tsv").Output()
+	if err != nil {
+		return "", fmt.Errorf("error running 'az keyvault secret show': %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// keyringCredentialProvider resolves "keyring:service/account" via the
+// system keyring, shelling out to the `keyring` CLI (python-keyring) since
+// this CLI doesn't vendor a platform keyring binding.
+type keyringCredentialProvider struct{}
+
+func (keyringCredentialProvider) Resolve(ref string) (string, error) {
+	service, account, ok := strings.Cut(ref, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid keyring ref %q: expected <service>/<account>", ref)
+	}
+	out, err := exec.Command("keyring", "get", service, account).Output()
+	if err != nil {
+		return "", fmt.Errorf("error running 'keyring get %s %s': %w", service, account, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// awsSecretsManagerCredentialProvider resolves "aws-secretsmanager:<secret-id-or-arn>"
+// by shelling out to the AWS CLI.
+type awsSecretsManagerCredentialProvider struct{}
+
+func (awsSecretsManagerCredentialProvider) Resolve(ref string) (string, error) {
+	out, err := exec.Command("aws", "secretsmanager", "get-secret-value", "--secret-id", ref, "--query", "SecretString", "--output", "text").Output()
+	if err != nil {
+		return "", fmt.Errorf("error running 'aws secretsmanager get-secret-value': %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// gcpSecretManagerCredentialProvider resolves "gcp-sm:projects/.../secrets/...[/versions/...]"
+// by shelling out to gcloud. A version suffix defaults to "latest" if omitted.
+type gcpSecretManagerCredentialProvider struct{}
+
+func (gcpSecretManagerCredentialProvider) Resolve(ref string) (string, error) {
+	secret := ref
+	version := "latest"
+	if idx := strings.Index(ref, "/versions/"); idx != -1 {
+		secret = ref[:idx]
+		version = ref[idx+len("/versions/"):]
+	}
+	out, err := exec.Command("gcloud", "secrets", "versions", "access", version, "--secret="+secret).Output()
+	if err != nil {
+		return "", fmt.Errorf("error running 'gcloud secrets versions access': %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// vaultCredentialProvider resolves "vault:<path>#<field>" (field defaults to
+// "value") by shelling out to the vault CLI.
+type vaultCredentialProvider struct{}
+
+func (vaultCredentialProvider) Resolve(ref string) (string, error) {
+	path, field, ok := strings.Cut(ref, "#")
+	if !ok {
+		field = "value"
+	}
+	out, err := exec.Command("vault", "read", "-field="+field, path).Output()
+	if err != nil {
+		return "", fmt.Errorf("error running 'vault read -field=%s %s': %w", field, path, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// redactCredential masks a resolved credential for display, keeping a short
+// prefix so operators can sanity-check which secret resolved without
+// printing the whole value.
+func redactCredential(value string) string {
+	if value == "" {
+		return "<empty>"
+	}
+	if len(value) <= 4 {
+		return strings.Repeat("*", len(value))
+	}
+	return value[:4] + strings.Repeat("*", len(value)-4)
+}
+
+// embedderCredentialsCmd groups credential-resolution helper commands.
+var embedderCredentialsCmd = &cobra.Command{
+	Use:   "credentials",
+	Short: "Inspect and test embedder credential references",
+}
+
+// testCredentialsCmd represents the credentials test command
+var testCredentialsCmd = &cobra.Command{
+	Use:   "test <credentials-ref>",
+	Short: "Resolve a credentials reference and print a redacted preview",
+	Long: `Resolves a credentials reference — either a --credentials-ref style
+value (e.g. env:OPENAI_API_KEY, file:/path, keyring:service/account,
+aws-secretsmanager:<id>, gcp-sm:<secret>, vault:<path>) or a --credentials
+URI-style reference (stdin:, azkv://vault/secret, aws-sm://<arn>,
+vault://path#field) — through its provider and prints a redacted preview,
+without ever printing the resolved secret in full. Useful for validating a
+reference before using it in 'embedder create'/'embedder update'.`,
+	Example: `  goodmem embedder credentials test env:OPENAI_API_KEY
+  goodmem embedder credentials test vault://secret/data/openai#key`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		resolved, err := resolveCredentialValue(args[0])
+		if err != nil {
+			return err
+		}
+		if resolved == args[0] {
+			return fmt.Errorf("%q doesn't look like a credentials reference (supported: %s, or scheme://...)", args[0], strings.Join(credentialProviderPrefixes(), ", "))
+		}
+
+		fmt.Printf("Resolved: %s\n", redactCredential(resolved))
+		return nil
+	},
+}
+
+func init() {
+	embedderCmd.AddCommand(embedderCredentialsCmd)
+	embedderCredentialsCmd.AddCommand(testCredentialsCmd)
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveCredentialValue(t *testing.T) {
+	t.Run("plain literal is returned unchanged", func(t *testing.T) {
+		got, err := resolveCredentialValue("sk-literal-value")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "sk-literal-value" {
+			t.Errorf("resolveCredentialValue() = %q, want %q", got, "sk-literal-value")
+		}
+	})
+
+	t.Run("empty value resolves to empty", func(t *testing.T) {
+		got, err := resolveCredentialValue("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "" {
+			t.Errorf("resolveCredentialValue(\"\") = %q, want \"\"", got)
+		}
+	})
+
+	t.Run("env prefix resolves from the process environment", func(t *testing.T) {
+		os.Setenv("GOODMEM_TEST_CRED", "s3cr3t")
+		defer os.Unsetenv("GOODMEM_TEST_CRED")
+
+		got, err := resolveCredentialValue("env:GOODMEM_TEST_CRED")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "s3cr3t" {
+			t.Errorf("resolveCredentialValue() = %q, want %q", got, "s3cr3t")
+		}
+	})
+
+	t.Run("env prefix errors on an unset variable", func(t *testing.T) {
+		os.Unsetenv("GOODMEM_TEST_CRED_UNSET")
+		if _, err := resolveCredentialValue("env:GOODMEM_TEST_CRED_UNSET"); err == nil {
+			t.Fatal("expected error for unset environment variable, got nil")
+		}
+	})
+
+	t.Run("file prefix reads and trims the file contents", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "secret.txt")
+		if err := os.WriteFile(path, []byte("file-secret\n"), 0o600); err != nil {
+			t.Fatalf("failed to write fixture file: %v", err)
+		}
+
+		got, err := resolveCredentialValue("file:" + path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "file-secret" {
+			t.Errorf("resolveCredentialValue() = %q, want %q", got, "file-secret")
+		}
+	})
+
+	t.Run("value without a reference prefix is treated as a literal value", func(t *testing.T) {
+		got, err := resolveCredentialValue("not-a-known-scheme")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "not-a-known-scheme" {
+			t.Errorf("resolveCredentialValue() = %q, want unchanged literal", got)
+		}
+	})
+
+	t.Run("a colon in a non-prefix literal (e.g. a URL) passes through", func(t *testing.T) {
+		got, err := resolveCredentialValue("https://example.com/secret")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "https://example.com/secret" {
+			t.Errorf("resolveCredentialValue() = %q, want unchanged literal", got)
+		}
+	})
+}
+
+func TestResolveCredentialsRef(t *testing.T) {
+	t.Run("env prefix resolves via the registry", func(t *testing.T) {
+		os.Setenv("GOODMEM_TEST_CRED_REF", "ref-secret")
+		defer os.Unsetenv("GOODMEM_TEST_CRED_REF")
+
+		got, err := resolveCredentialsRef("env:GOODMEM_TEST_CRED_REF")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "ref-secret" {
+			t.Errorf("resolveCredentialsRef() = %q, want %q", got, "ref-secret")
+		}
+	})
+
+	t.Run("missing prefix separator is an error", func(t *testing.T) {
+		if _, err := resolveCredentialsRef("no-separator-here"); err == nil {
+			t.Fatal("expected error for a --credentials-ref with no prefix, got nil")
+		}
+	})
+
+	t.Run("unknown prefix is an error", func(t *testing.T) {
+		if _, err := resolveCredentialsRef("bogus:whatever"); err == nil {
+			t.Fatal("expected error for an unregistered prefix, got nil")
+		}
+	})
+}
+
+func TestCredentialProviderPrefixes(t *testing.T) {
+	prefixes := credentialProviderPrefixes()
+	if len(prefixes) != len(credentialProviders) {
+		t.Fatalf("credentialProviderPrefixes() returned %d prefixes, want %d", len(prefixes), len(credentialProviders))
+	}
+	for i := 1; i < len(prefixes); i++ {
+		if prefixes[i-1] > prefixes[i] {
+			t.Errorf("credentialProviderPrefixes() is not sorted: %v", prefixes)
+			break
+		}
+	}
+}
+
+func TestRedactCredential(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "empty value", value: "", want: "<empty>"},
+		{name: "short value is fully masked", value: "abcd", want: "****"},
+		{name: "long value keeps a 4-char prefix", value: "sk-1234567890", want: "sk-1*********"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactCredential(tt.value); got != tt.want {
+				t.Errorf("redactCredential(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/spf13/cobra"
+)
+
+// daemonControlPrefix is the path prefix reserved for the daemon's own
+// internal RPC. Anything outside this prefix is proxied straight through to
+// the configured GoodMem server, so the generated v1connect clients don't
+// need to know the daemon exists.
+const daemonControlPrefix = "/_goodmemd/"
+
+var (
+	noDaemon         bool
+	daemonSocketPath string
+)
+
+// daemonState holds the daemon's mutable, reload-able configuration behind a
+// mutex so Reload can swap it out while requests are in flight.
+type daemonState struct {
+	mu       sync.RWMutex
+	cfg      ConfigFile
+	upstream *httputil.ReverseProxy
+	started  time.Time
+}
+
+func (s *daemonState) proxy() *httputil.ReverseProxy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.upstream
+}
+
+func (s *daemonState) reload(configFilePath string) error {
+	cfg, err := readConfigFile(configFilePath)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cfg = cfg
+	s.upstream = newUpstreamProxy(cfg.ServerAddress)
+	return nil
+}
+
+// newUpstreamProxy builds a reverse proxy that forwards requests to the real
+// GoodMem server over a warm, reused HTTP/2 connection, so CLI invocations
+// that go through the daemon skip the per-command TLS/HTTP2 handshake.
+func newUpstreamProxy(serverAddress string) *httputil.ReverseProxy {
+	upstreamURL, err := parseUpstreamURL(serverAddress)
+	if err != nil {
+		// Fall back to localhost default; Director still rewrites per-request.
+		upstreamURL, _ = parseUpstreamURL("https://localhost:9090")
+	}
+
+	transport := createUpstreamHTTPClient(true, serverAddress).Transport
+
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = upstreamURL.Scheme
+			req.URL.Host = upstreamURL.Host
+			req.Host = upstreamURL.Host
+		},
+		Transport: transport,
+	}
+	return proxy
+}
+
+// daemonCmd runs the long-lived background process. It listens on a Unix
+// domain socket and forwards requests through a reverse proxy whose warm,
+// reused HTTP/2 transport connects to the configured server, so the CLI
+// avoids a fresh HTTP/2 handshake on every invocation.
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Run the GoodMem background daemon",
+	Long: `Runs a long-lived background process that holds a warm connection to the
+GoodMem server and exposes it to CLI invocations over a Unix domain socket.
+
+createHTTPClient transparently routes through the socket when it exists,
+eliminating the per-command TLS/HTTP2 handshake. Run with --no-daemon on any
+other command to bypass it.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		sockPath, err := resolveDaemonSocketPath(daemonSocketPath)
+		if err != nil {
+			return err
+		}
+
+		release, err := acquireDaemonLock(sockPath)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		if err := os.RemoveAll(sockPath); err != nil {
+			return fmt.Errorf("error clearing stale socket: %w", err)
+		}
+
+		listener, err := net.Listen("unix", sockPath)
+		if err != nil {
+			return fmt.Errorf("error listening on %s: %w", sockPath, err)
+		}
+		defer listener.Close()
+
+		if err := os.Chmod(sockPath, 0600); err != nil {
+			return fmt.Errorf("error setting socket permissions: %w", err)
+		}
+
+		state := &daemonState{started: time.Now()}
+		cfgFile := resolveConfigFilePath()
+		if cfg, err := readConfigFile(cfgFile); err == nil {
+			state.cfg = cfg
+		} else {
+			state.cfg = ConfigFile{ServerAddress: serverAddress}
+		}
+		state.upstream = newUpstreamProxy(state.cfg.ServerAddress)
+
+		httpHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(r.URL.Path) >= len(daemonControlPrefix) && r.URL.Path[:len(daemonControlPrefix)] == daemonControlPrefix {
+				serveDaemonControl(w, r, state, cfgFile)
+				return
+			}
+			state.proxy().ServeHTTP(w, r)
+		})
+
+		server := &http.Server{
+			Handler: h2c.NewHandler(httpHandler, &http2.Server{}),
+		}
+
+		fmt.Printf("goodmemd listening on %s (upstream %s)\n", sockPath, state.cfg.ServerAddress)
+		return server.Serve(listener)
+	},
+}
+
+// serveDaemonControl answers the daemon's small internal RPC (Ping, Reload,
+// Status) with plain JSON bodies; these never need to look like a gRPC call.
+func serveDaemonControl(w http.ResponseWriter, r *http.Request, state *daemonState, cfgFile string) {
+	method := r.URL.Path[len(daemonControlPrefix):]
+	w.Header().Set("Content-Type", "application/json")
+
+	switch method {
+	case "ping":
+		json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	case "status":
+		state.mu.RLock()
+		defer state.mu.RUnlock()
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"upstream":   state.cfg.ServerAddress,
+			"uptime_sec": int(time.Since(state.started).Seconds()),
+			"pid":        os.Getpid(),
+		})
+	case "reload":
+		if err := state.reload(cfgFile); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"status": "reloaded"})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": "unknown method: " + method})
+	}
+}
+
+// resolveDaemonSocketPath returns the configured socket path, defaulting to
+// ~/.goodmem/goodmemd.sock.
+func resolveDaemonSocketPath(explicit string) (string, error) {
+	if explicit != "" {
+		return explicit, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error getting user home directory: %w", err)
+	}
+	return filepath.Join(home, ".goodmem", "goodmemd.sock"), nil
+}
+
+// resolveConfigFilePath mirrors the default used by init.go's config flow.
+func resolveConfigFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".goodmem", "config.json")
+}
+
+// acquireDaemonLock enforces single-instance semantics via a lockfile next
+// to the socket, refusing to start if another live daemon already holds it.
+func acquireDaemonLock(sockPath string) (func(), error) {
+	lockPath := sockPath + ".lock"
+
+	if data, err := os.ReadFile(lockPath); err == nil {
+		if pid, err := strconv.Atoi(string(data)); err == nil && processAlive(pid) {
+			return nil, fmt.Errorf("goodmemd already running (pid %d, lock %s)", pid, lockPath)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(lockPath), 0700); err != nil {
+		return nil, fmt.Errorf("error creating daemon directory: %w", err)
+	}
+
+	if err := os.WriteFile(lockPath, []byte(strconv.Itoa(os.Getpid())), 0600); err != nil {
+		return nil, fmt.Errorf("error writing lockfile: %w", err)
+	}
+
+	return func() { os.Remove(lockPath) }, nil
+}
+
+func processAlive(pid int) bool {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return proc.Signal(syscall.Signal(0)) == nil
+}
+
+func parseUpstreamURL(serverAddress string) (*url.URL, error) {
+	return url.Parse(serverAddress)
+}
+
+// daemonHTTPClient builds an h2c client whose every connection dials the
+// goodmemd Unix socket, regardless of the server address passed to the
+// v1connect constructors. The daemon's own Director re-points each request
+// at the real upstream, so this stays transparent to callers.
+func daemonHTTPClient(sockPath string) *http.Client {
+	transport := &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return net.Dial("unix", sockPath)
+		},
+	}
+	return &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: transport,
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(daemonCmd)
+	daemonCmd.Flags().StringVar(&daemonSocketPath, "socket", "", "Unix socket path to listen on (defaults to ~/.goodmem/goodmemd.sock)")
+
+	rootCmd.PersistentFlags().BoolVar(&noDaemon, "no-daemon", false, "Bypass the goodmemd socket even if it is running and connect directly")
+}
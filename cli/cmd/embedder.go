@@ -25,6 +25,7 @@ var (
 	maxSequenceLength  int32
 	supportedModalities []string
 	credentials        string
+	credentialsRef     string
 	embedderVersion    string // Renamed from version to avoid conflict
 	monitoringEndpoint string
 	embedderLabels     []string
@@ -95,8 +96,26 @@ var createEmbedderCmd = &cobra.Command{
   goodmem embedder create --display-name "Multimodal Embedder" --provider-type OPENAI --endpoint-url "https://api.openai.com" --model-identifier "multi-embed" --dimensionality 1536 --credentials "YOUR_API_KEY" --modality TEXT --modality IMAGE
   
   # Create an embedder for another user (requires admin permissions)
-  goodmem embedder create --display-name "Team Embedder" --provider-type OPENAI --endpoint-url "https://api.openai.com" --model-identifier "text-embedding-3-small" --dimensionality 1536 --credentials "YOUR_API_KEY" --owner 123e4567-e89b-12d3-a456-426614174000`,
+  goodmem embedder create --display-name "Team Embedder" --provider-type OPENAI --endpoint-url "https://api.openai.com" --model-identifier "text-embedding-3-small" --dimensionality 1536 --credentials "YOUR_API_KEY" --owner 123e4567-e89b-12d3-a456-426614174000
+
+  # Create an embedder from a built-in preset (see 'embedder presets list')
+  goodmem embedder create --display-name "OpenAI Small" --preset openai/text-embedding-3-small --credentials-ref env:OPENAI_API_KEY`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// A bare `embedder create` with no flags, or an explicit
+		// --interactive, walks the user through provider selection instead
+		// of requiring all the flags up front.
+		if createInteractive || cmd.Flags().NFlag() == 0 {
+			if err := runEmbedderWizard(cmd); err != nil {
+				return err
+			}
+		}
+
+		if createPreset != "" {
+			if err := applyEmbedderPreset(cmd, createPreset); err != nil {
+				return err
+			}
+		}
+
 		// Validate required inputs
 		if displayName == "" {
 			return fmt.Errorf("display name is required")
@@ -118,8 +137,8 @@ var createEmbedderCmd = &cobra.Command{
 			return fmt.Errorf("dimensionality must be a positive integer")
 		}
 		
-		if credentials == "" {
-			return fmt.Errorf("credentials are required")
+		if credentials == "" && credentialsRef == "" {
+			return fmt.Errorf("credentials are required (use --credentials, --credentials-from, or --credentials-ref)")
 		}
 
 		// After client-side validation passes, silence usage for server-side errors
@@ -130,9 +149,30 @@ var createEmbedderCmd = &cobra.Command{
 		client := v1connect.NewEmbedderServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
+		// Resolve --credentials-ref through the credential provider registry
+		// in preference to a literal --credentials/--credentials-from value.
+		// --credentials/--credentials-from may themselves be a URI-style
+		// secret reference (env:VAR, file:/path, stdin:, azkv://..., ...);
+		// resolveCredentialValue resolves those and passes anything else
+		// through unchanged. Either way the resolver URI is never persisted,
+		// only the resolved secret is sent to the server.
+		if credentialsRef != "" {
+			resolved, err := resolveCredentialsRef(credentialsRef)
+			if err != nil {
+				return err
+			}
+			credentials = resolved
+		} else {
+			resolved, err := resolveCredentialValue(credentials)
+			if err != nil {
+				return err
+			}
+			credentials = resolved
+		}
+
 		// Parse the provider type
 		protoProviderType, err := parseProviderType(providerType)
 		if err != nil {
@@ -193,6 +233,16 @@ var createEmbedderCmd = &cobra.Command{
 			req.OwnerId = ownerIDBytes
 		}
 
+		// When --verify is set, probe the endpoint before registering the
+		// embedder so a broken endpoint/credential/dimensionality
+		// combination is caught here instead of at ingestion time.
+		if createVerify {
+			diag := verifyEmbedderEndpoint(req.EndpointUrl, req.ApiPath, req.ModelIdentifier, req.Dimensionality, verifySampleText, credentials, verifyTimeout)
+			if err := reportVerifyDiagnostic(diag); err != nil {
+				return err
+			}
+		}
+
 		// Create the connect request
 		connectReq := connect.NewRequest(req)
 
@@ -335,7 +385,7 @@ var getEmbedderCmd = &cobra.Command{
 		client := v1connect.NewEmbedderServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		req := connect.NewRequest(&v1.GetEmbedderRequest{
@@ -412,7 +462,7 @@ var listEmbeddersCmd = &cobra.Command{
 		client := v1connect.NewEmbedderServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse labels from key=value format
@@ -574,7 +624,7 @@ var deleteEmbedderCmd = &cobra.Command{
 		client := v1connect.NewEmbedderServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		req := connect.NewRequest(&v1.DeleteEmbedderRequest{
@@ -645,7 +695,7 @@ var updateEmbedderCmd = &cobra.Command{
 		client := v1connect.NewEmbedderServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse labels from key=value format
@@ -687,7 +737,19 @@ var updateEmbedderCmd = &cobra.Command{
 			updateReq.MaxSequenceLength = &maxSequenceLength
 		}
 
-		if cmd.Flags().Changed("credentials") {
+		if cmd.Flags().Changed("credentials-ref") {
+			resolved, err := resolveCredentialsRef(credentialsRef)
+			if err != nil {
+				return err
+			}
+			credentials = resolved
+			updateReq.Credentials = &credentials
+		} else if cmd.Flags().Changed("credentials") || cmd.Flags().Changed("credentials-from") {
+			resolved, err := resolveCredentialValue(credentials)
+			if err != nil {
+				return err
+			}
+			credentials = resolved
 			updateReq.Credentials = &credentials
 		}
 
@@ -759,6 +821,23 @@ var updateEmbedderCmd = &cobra.Command{
 			return fmt.Errorf("error formatting response as JSON: %w", err)
 		}
 		fmt.Println(string(jsonBytes))
+
+		// When --verify is set, probe the endpoint with the now-applied
+		// configuration. If credentials weren't part of this update, the
+		// verification runs without one since the server never returns a
+		// stored credential back to us.
+		if updateVerify {
+			updated := resp.Msg
+			verifyCredentials := ""
+			if cmd.Flags().Changed("credentials-ref") || cmd.Flags().Changed("credentials") || cmd.Flags().Changed("credentials-from") {
+				verifyCredentials = credentials
+			}
+			diag := verifyEmbedderEndpoint(updated.EndpointUrl, updated.ApiPath, updated.ModelIdentifier, updated.Dimensionality, verifySampleText, verifyCredentials, verifyTimeout)
+			if err := reportVerifyDiagnostic(diag); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	},
 }
@@ -781,30 +860,24 @@ func init() {
 	createEmbedderCmd.Flags().Int32Var(&dimensionality, "dimensionality", 0, "Output vector dimensions")
 	createEmbedderCmd.Flags().Int32Var(&maxSequenceLength, "max-sequence-length", 0, "Maximum input sequence length")
 	createEmbedderCmd.Flags().StringSliceVar(&supportedModalities, "modality", []string{}, "Supported modalities (TEXT, IMAGE, AUDIO, VIDEO)")
-	createEmbedderCmd.Flags().StringVar(&credentials, "credentials", "", "API credentials (will be encrypted)")
+	createEmbedderCmd.Flags().StringVar(&credentials, "credentials", "", "API credentials: a literal value, or a URI-style reference (env:VAR, file:/path, stdin:, azkv://vault/secret, aws-sm://<arn>, vault://path#field)")
+	createEmbedderCmd.Flags().StringVar(&credentials, "credentials-from", "", "Alias for --credentials, for clarity when passing a secret reference")
+	createEmbedderCmd.Flags().StringVar(&credentialsRef, "credentials-ref", "", "Resolve credentials from a provider instead of a literal value (env:VAR, file:/path, keyring:service/account, aws-secretsmanager:<id>, gcp-sm:<secret>, vault:<path>)")
 	createEmbedderCmd.Flags().StringSliceVarP(&embedderLabels, "label", "l", []string{}, "Labels in key=value format (can be specified multiple times)")
 	createEmbedderCmd.Flags().StringVar(&embedderVersion, "version", "", "Optional version information")
 	createEmbedderCmd.Flags().StringVar(&monitoringEndpoint, "monitoring-endpoint", "", "Optional monitoring endpoint")
 	createEmbedderCmd.Flags().StringVar(&ownerIDStr, "owner", "", "Owner ID for the embedder (requires admin permissions)")
-
-	// Required flags for create command
-	if err := createEmbedderCmd.MarkFlagRequired("display-name"); err != nil {
-		panic(fmt.Sprintf("Failed to mark flag 'display-name' as required: %v", err))
-	}
-	if err := createEmbedderCmd.MarkFlagRequired("provider-type"); err != nil {
-		panic(fmt.Sprintf("Failed to mark flag 'provider-type' as required: %v", err))
-	}
-	if err := createEmbedderCmd.MarkFlagRequired("endpoint-url"); err != nil {
-		panic(fmt.Sprintf("Failed to mark flag 'endpoint-url' as required: %v", err))
-	}
-	if err := createEmbedderCmd.MarkFlagRequired("model-identifier"); err != nil {
-		panic(fmt.Sprintf("Failed to mark flag 'model-identifier' as required: %v", err))
-	}
-	if err := createEmbedderCmd.MarkFlagRequired("dimensionality"); err != nil {
-		panic(fmt.Sprintf("Failed to mark flag 'dimensionality' as required: %v", err))
-	}
-	if err := createEmbedderCmd.MarkFlagRequired("credentials"); err != nil {
-		panic(fmt.Sprintf("Failed to mark flag 'credentials' as required: %v", err))
+	createEmbedderCmd.Flags().BoolVarP(&createInteractive, "interactive", "i", false, "Walk through provider selection and field defaults interactively")
+
+	// display-name, provider-type, endpoint-url, model-identifier, and
+	// dimensionality are intentionally not MarkFlagRequired: cobra validates
+	// required flags before RunE runs, which would reject a bare
+	// `embedder create` (the wizard trigger) and `--preset` (which fills
+	// these same flags from inside RunE) before either ever got a chance to
+	// fill them in. They're validated manually in RunE instead, alongside
+	// the same treatment --credentials already gets there.
+	if err := createEmbedderCmd.RegisterFlagCompletionFunc("credentials-ref", completeCredentialRefPrefixes); err != nil {
+		panic(fmt.Sprintf("Failed to register completion for flag 'credentials-ref': %v", err))
 	}
 
 	// Flags for list command
@@ -824,9 +897,15 @@ func init() {
 	updateEmbedderCmd.Flags().Int32Var(&dimensionality, "dimensionality", 0, "New output vector dimensions")
 	updateEmbedderCmd.Flags().Int32Var(&maxSequenceLength, "max-sequence-length", 0, "New maximum input sequence length")
 	updateEmbedderCmd.Flags().StringSliceVar(&supportedModalities, "modality", []string{}, "New supported modalities (TEXT, IMAGE, AUDIO, VIDEO)")
-	updateEmbedderCmd.Flags().StringVar(&credentials, "credentials", "", "New API credentials")
+	updateEmbedderCmd.Flags().StringVar(&credentials, "credentials", "", "New API credentials: a literal value, or a URI-style reference (env:VAR, file:/path, stdin:, azkv://vault/secret, aws-sm://<arn>, vault://path#field)")
+	updateEmbedderCmd.Flags().StringVar(&credentials, "credentials-from", "", "Alias for --credentials, for clarity when passing a secret reference")
+	updateEmbedderCmd.Flags().StringVar(&credentialsRef, "credentials-ref", "", "Resolve new credentials from a provider instead of a literal value (env:VAR, file:/path, keyring:service/account, aws-secretsmanager:<id>, gcp-sm:<secret>, vault:<path>)")
 	updateEmbedderCmd.Flags().StringSliceVarP(&embedderLabels, "label", "l", []string{}, "New labels in key=value format (can be specified multiple times)")
 	updateEmbedderCmd.Flags().StringVar(&labelUpdateStrategy, "label-strategy", "replace", "Label update strategy: 'replace' to overwrite all existing labels, 'merge' to add to existing labels")
 	updateEmbedderCmd.Flags().StringVar(&embedderVersion, "version", "", "New version information")
 	updateEmbedderCmd.Flags().StringVar(&monitoringEndpoint, "monitoring-endpoint", "", "New monitoring endpoint")
+
+	if err := updateEmbedderCmd.RegisterFlagCompletionFunc("credentials-ref", completeCredentialRefPrefixes); err != nil {
+		panic(fmt.Sprintf("Failed to register completion for flag 'credentials-ref': %v", err))
+	}
 }
\ No newline at end of file
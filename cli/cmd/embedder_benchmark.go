@@ -0,0 +1,321 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+	v1connect "github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
+	"github.com/spf13/cobra"
+)
+
+var (
+	benchmarkCompare     string
+	benchmarkConcurrency int
+	benchmarkDuration    time.Duration
+	benchmarkWarmup      time.Duration
+	benchmarkCredentials string
+)
+
+// syntheticTextCorpus is cycled through when benchmarking a TEXT-capable
+// embedder and the user hasn't supplied real inputs. Varying sentence length
+// gives a more representative tokens/sec figure than repeating one string.
+var syntheticTextCorpus = []string{
+	"The quick brown fox jumps over the lazy dog.",
+	"GoodMem stores and retrieves memories for AI agents.",
+	"Benchmarking an embedder measures latency, throughput, and cost.",
+	"A concurrent worker pool issues requests for the configured duration.",
+	"Percentile latencies reveal tail behavior that averages hide.",
+}
+
+// openAICostPer1kTokens gives an approximate, best-effort USD cost per 1,000
+// input tokens for well-known OpenAI embedding models, used only to print a
+// rough cost-per-1k figure when providerType is OPENAI. Returns false for
+// unrecognized models rather than guessing.
+func openAICostPer1kTokens(modelIdentifier string) (float64, bool) {
+	switch modelIdentifier {
+	case "text-embedding-3-small":
+		return 0.00002, true
+	case "text-embedding-3-large":
+		return 0.00013, true
+	case "text-embedding-ada-002":
+		return 0.0001, true
+	default:
+		return 0, false
+	}
+}
+
+// benchmarkResult aggregates one embedder+modality benchmark run.
+type benchmarkResult struct {
+	EmbedderID   string        `json:"embedder_id"`
+	DisplayName  string        `json:"display_name"`
+	Modality     string        `json:"modality"`
+	Requests     int64         `json:"requests"`
+	Failures     int64         `json:"failures"`
+	Duration     time.Duration `json:"duration_ns"`
+	Throughput   float64       `json:"requests_per_sec"`
+	P50Latency   time.Duration `json:"p50_latency_ns"`
+	P95Latency   time.Duration `json:"p95_latency_ns"`
+	P99Latency   time.Duration `json:"p99_latency_ns"`
+	TokensPerSec float64       `json:"tokens_per_sec,omitempty"`
+	CostPer1k    *float64      `json:"cost_per_1k_tokens_usd,omitempty"`
+}
+
+// benchmarkEmbedderCmd represents the benchmark command
+var benchmarkEmbedderCmd = &cobra.Command{
+	Use:   "benchmark [embedder-id]",
+	Short: "Benchmark an embedder's throughput, latency, and cost",
+	Long: `Drives an embedder with synthetic text input for --duration, reporting
+throughput, p50/p95/p99 latency, tokens/sec, and (for providerType OPENAI)
+an approximate cost-per-1k-tokens. Only the TEXT modality is benchmarked:
+each other declared SupportedModalities entry (IMAGE, AUDIO, VIDEO) is
+reported as skipped. Pass --compare a,b,c to run the same workload against
+several embedder IDs and print a side-by-side summary, making it easy to
+choose between registered OpenAI/vLLM/TEI backends.`,
+	Example: `  # Benchmark a single embedder for 10 seconds at concurrency 8
+  goodmem embedder benchmark 123e4567-e89b-12d3-a456-426614174000 --duration 10s --concurrency 8
+
+  # Compare three embedders side by side
+  goodmem embedder benchmark --compare 123e4567-...,223e4567-...,323e4567-...`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		var idStrs []string
+		if benchmarkCompare != "" {
+			for _, s := range strings.Split(benchmarkCompare, ",") {
+				s = strings.TrimSpace(s)
+				if s != "" {
+					idStrs = append(idStrs, s)
+				}
+			}
+		} else if len(args) == 1 {
+			idStrs = []string{args[0]}
+		} else {
+			return fmt.Errorf("provide an embedder ID, or pass --compare a,b,c to benchmark several")
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewEmbedderServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		var allResults []benchmarkResult
+		for _, idStr := range idStrs {
+			embedderID, err := uuidStringToBytes(idStr)
+			if err != nil {
+				return fmt.Errorf("invalid embedder ID %q: %w", idStr, err)
+			}
+
+			req := connect.NewRequest(&v1.GetEmbedderRequest{EmbedderId: embedderID})
+			if err := addAuthHeader(req); err != nil {
+				return err
+			}
+			resp, err := client.GetEmbedder(context.Background(), req)
+			if err != nil {
+				return unwrapConnectError(err)
+			}
+			embedder := resp.Msg
+
+			modalities := embedder.SupportedModalities
+			if len(modalities) == 0 {
+				modalities = []v1.Modality{v1.Modality_MODALITY_TEXT}
+			}
+
+			for _, modality := range modalities {
+				fmt.Printf("Benchmarking %s (%s)...\n", idStr, strings.TrimPrefix(modality.String(), "MODALITY_"))
+				result, err := benchmarkEmbedderModality(embedder, modality)
+				if err != nil {
+					fmt.Printf("  skipped: %v\n", err)
+					continue
+				}
+				allResults = append(allResults, result)
+			}
+		}
+
+		return renderBenchmarkResults(allResults)
+	},
+}
+
+// benchmarkEmbedderModality runs the configured warmup and timed workload
+// for one embedder+modality pair and returns the aggregated result.
+func benchmarkEmbedderModality(embedder *v1.Embedder, modality v1.Modality) (benchmarkResult, error) {
+	if modality != v1.Modality_MODALITY_TEXT {
+		return benchmarkResult{}, fmt.Errorf("benchmarking %s inputs requires a corpus directory, which isn't wired up yet; only TEXT is benchmarked with synthetic input today", strings.TrimPrefix(modality.String(), "MODALITY_"))
+	}
+
+	endpoint, err := probeEndpointURL(embedder)
+	if err != nil {
+		return benchmarkResult{}, err
+	}
+
+	run := func(duration time.Duration) (requests int64, failures int64, latencies []time.Duration, tokens int64) {
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		stop := make(chan struct{})
+		time.AfterFunc(duration, func() { close(stop) })
+
+		concurrency := benchmarkConcurrency
+		if concurrency < 1 {
+			concurrency = 1
+		}
+
+		var reqCount, failCount, tokenCount int64
+		for i := 0; i < concurrency; i++ {
+			wg.Add(1)
+			go func(worker int) {
+				defer wg.Done()
+				for i := 0; ; i++ {
+					select {
+					case <-stop:
+						return
+					default:
+					}
+
+					text := syntheticTextCorpus[(worker+i)%len(syntheticTextCorpus)]
+					latency, err := benchmarkEmbedOnce(endpoint, embedder.ModelIdentifier, text)
+					atomic.AddInt64(&reqCount, 1)
+					if err != nil {
+						atomic.AddInt64(&failCount, 1)
+						continue
+					}
+					atomic.AddInt64(&tokenCount, int64(len(strings.Fields(text))))
+
+					mu.Lock()
+					latencies = append(latencies, latency)
+					mu.Unlock()
+				}
+			}(i)
+		}
+		wg.Wait()
+		return reqCount, failCount, latencies, tokenCount
+	}
+
+	if benchmarkWarmup > 0 {
+		run(benchmarkWarmup)
+	}
+
+	start := time.Now()
+	requests, failures, latencies, tokens := run(benchmarkDuration)
+	elapsed := time.Since(start)
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	result := benchmarkResult{
+		EmbedderID:   formatUUID(embedder.EmbedderId),
+		DisplayName:  embedder.DisplayName,
+		Modality:     strings.TrimPrefix(modality.String(), "MODALITY_"),
+		Requests:     requests,
+		Failures:     failures,
+		Duration:     elapsed,
+		Throughput:   float64(requests) / elapsed.Seconds(),
+		P50Latency:   percentile(latencies, 50),
+		P95Latency:   percentile(latencies, 95),
+		P99Latency:   percentile(latencies, 99),
+		TokensPerSec: float64(tokens) / elapsed.Seconds(),
+	}
+
+	if embedder.ProviderType == v1.ProviderType_PROVIDER_TYPE_OPENAI {
+		if cost, ok := openAICostPer1kTokens(embedder.ModelIdentifier); ok {
+			result.CostPer1k = &cost
+		}
+	}
+
+	return result, nil
+}
+
+func benchmarkEmbedOnce(endpoint, model, input string) (time.Duration, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"model": model,
+		"input": input,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if benchmarkCredentials != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+benchmarkCredentials)
+	}
+
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(httpReq)
+	latency := time.Since(start)
+	if err != nil {
+		return latency, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return latency, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return latency, nil
+}
+
+func renderBenchmarkResults(results []benchmarkResult) error {
+	if len(results) == 0 {
+		fmt.Println("No benchmarks ran.")
+		return nil
+	}
+
+	switch outputFormat {
+	case "json":
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error formatting results as JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	case "compact":
+		for _, r := range results {
+			fmt.Printf("%s\t%s\t%.1f req/s\tp50=%s p95=%s p99=%s\n", r.EmbedderID, r.Modality, r.Throughput, r.P50Latency, r.P95Latency, r.P99Latency)
+		}
+	default:
+		fmt.Printf("\n%-38s %-8s %-10s %-10s %-10s %-10s %-12s %s\n",
+			"EMBEDDER ID", "MODALITY", "REQ/S", "P50", "P95", "P99", "TOK/S", "COST/1K")
+		fmt.Println(strings.Repeat("-", 110))
+		for _, r := range results {
+			cost := "n/a"
+			if r.CostPer1k != nil {
+				cost = fmt.Sprintf("$%.5f", *r.CostPer1k)
+			}
+			fmt.Printf("%-38s %-8s %-10.1f %-10s %-10s %-10s %-12.1f %s\n",
+				r.EmbedderID, r.Modality, r.Throughput, r.P50Latency, r.P95Latency, r.P99Latency, r.TokensPerSec, cost)
+		}
+		if len(results) > 1 {
+			fmt.Println("\nHighest throughput:")
+			best := results[0]
+			for _, r := range results[1:] {
+				if r.Throughput > best.Throughput {
+					best = r
+				}
+			}
+			fmt.Printf("  %s (%s) at %.1f req/s\n", best.EmbedderID, best.Modality, best.Throughput)
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	embedderCmd.AddCommand(benchmarkEmbedderCmd)
+
+	benchmarkEmbedderCmd.Flags().StringVar(&benchmarkCompare, "compare", "", "Comma-separated embedder IDs to benchmark side by side instead of a single positional ID")
+	benchmarkEmbedderCmd.Flags().IntVar(&benchmarkConcurrency, "concurrency", 4, "Number of concurrent workers issuing requests")
+	benchmarkEmbedderCmd.Flags().DurationVar(&benchmarkDuration, "duration", 10*time.Second, "How long to run the timed workload")
+	benchmarkEmbedderCmd.Flags().DurationVar(&benchmarkWarmup, "warmup", 2*time.Second, "How long to run an untimed warmup workload before measuring")
+	benchmarkEmbedderCmd.Flags().StringVar(&benchmarkCredentials, "credentials", "", "Bearer credential to use against the embedder endpoint (stored credentials are not returned by the API)")
+	benchmarkEmbedderCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", "Output format (json, table, or compact)")
+}
@@ -0,0 +1,621 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+	v1connect "github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	embedderApplyFile     string
+	embedderApplyDryRun   bool
+	embedderApplyPrune    bool
+	embedderApplyOwner    string
+	embedderApplySelector string
+
+	embedderExportOutput string
+)
+
+// embedderManifestEntry is one entry of the declarative document read by
+// `embedder apply -f` and written by `embedder export`. Credentials is
+// resolved through resolveCredentialValue, the same resolver used by
+// --credentials/--credentials-from, so secrets can be referenced instead of
+// checked in (e.g. "env:OPENAI_API_KEY" or "file:/run/secrets/key").
+type embedderManifestEntry struct {
+	ID                  string            `yaml:"id,omitempty" json:"id,omitempty"`
+	DisplayName         string            `yaml:"display_name" json:"display_name"`
+	Description         string            `yaml:"description,omitempty" json:"description,omitempty"`
+	ProviderType        string            `yaml:"provider_type" json:"provider_type"`
+	EndpointURL         string            `yaml:"endpoint_url" json:"endpoint_url"`
+	ApiPath             string            `yaml:"api_path,omitempty" json:"api_path,omitempty"`
+	ModelIdentifier     string            `yaml:"model_identifier" json:"model_identifier"`
+	Dimensionality      int32             `yaml:"dimensionality" json:"dimensionality"`
+	MaxSequenceLength   int32             `yaml:"max_sequence_length,omitempty" json:"max_sequence_length,omitempty"`
+	SupportedModalities []string          `yaml:"supported_modalities,omitempty" json:"supported_modalities,omitempty"`
+	Credentials         string            `yaml:"credentials,omitempty" json:"credentials,omitempty"`
+	Labels              map[string]string `yaml:"labels,omitempty" json:"labels,omitempty"`
+	Version             string            `yaml:"version,omitempty" json:"version,omitempty"`
+	MonitoringEndpoint  string            `yaml:"monitoring_endpoint,omitempty" json:"monitoring_endpoint,omitempty"`
+	Owner               string            `yaml:"owner,omitempty" json:"owner,omitempty"`
+	LabelStrategy       string            `yaml:"label_strategy,omitempty" json:"label_strategy,omitempty"`
+}
+
+// embedderManifestDocument is the "kind: EmbedderList" wrapper form accepted
+// alongside a bare array of entries, mirroring how other manifest-driven
+// tools typically version and group a list of resources.
+type embedderManifestDocument struct {
+	Kind  string                  `yaml:"kind" json:"kind"`
+	Items []embedderManifestEntry `yaml:"items" json:"items"`
+}
+
+// applyEmbeddersCmd represents the apply command
+var applyEmbeddersCmd = &cobra.Command{
+	Use:   "apply -f <file>",
+	Short: "Converge embedders to match a declarative manifest",
+	Long: `Reads a YAML or JSON document listing the desired embedders (a bare
+array, or a "kind: EmbedderList" document with an items list), diffs it
+against the server's ListEmbedders output — matched by explicit id when an
+entry sets one, otherwise by display_name+owner — and issues
+CreateEmbedder/UpdateEmbedder/DeleteEmbedder calls to converge state. Each
+entry's credentials field may be a literal value or a reference resolved the
+same way as --credentials-from (e.g. "env:OPENAI_API_KEY",
+"file:/run/secrets/key", "vault:secret/data/openai#key"). Pass -f - to read
+the manifest from stdin. Pass --prune to also delete embedders that exist on
+the server but are absent from the manifest, optionally scoped with --owner
+or --selector so prune only considers a subset of embedders.`,
+	Example: `  # Preview what would change
+  goodmem embedder apply -f embedders.yaml --dry-run
+
+  # Apply the manifest, deleting embedders no longer listed
+  goodmem embedder apply -f embedders.yaml --prune
+
+  # Read the manifest from stdin, scoping prune to one owner
+  cat embedders.yaml | goodmem embedder apply -f - --prune --owner 123e4567-e89b-12d3-a456-426614174000`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		if embedderApplyFile == "" {
+			return fmt.Errorf("-f/--file is required")
+		}
+
+		desired, err := readEmbedderManifest(embedderApplyFile)
+		if err != nil {
+			return err
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewEmbedderServiceClient(
+			httpClient,
+			serverAddress,
+			connectClientOptions()...,
+		)
+
+		listReq := connect.NewRequest(&v1.ListEmbeddersRequest{})
+		if err := addAuthHeader(listReq); err != nil {
+			return err
+		}
+		listResp, err := client.ListEmbedders(context.Background(), listReq)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		existingByID := make(map[string]*v1.Embedder)
+		existingByKey := make(map[string]*v1.Embedder)
+		for _, embedder := range listResp.Msg.Embedders {
+			ownerIDStr, _ := uuidBytesToString(embedder.OwnerId)
+			existingByID[formatUUID(embedder.EmbedderId)] = embedder
+			existingByKey[embedder.DisplayName+"|"+ownerIDStr] = embedder
+		}
+
+		pruneScope, err := parseLabelSelector(embedderApplySelector)
+		if err != nil {
+			return err
+		}
+
+		seenIDs := make(map[string]bool)
+		var created, updated, deleted int
+
+		for _, entry := range desired {
+			var existing *v1.Embedder
+			if entry.ID != "" {
+				existing = existingByID[entry.ID]
+			} else {
+				existing = existingByKey[entry.DisplayName+"|"+entry.Owner]
+			}
+
+			if existing != nil {
+				seenIDs[formatUUID(existing.EmbedderId)] = true
+
+				if embedderMatchesManifest(existing, entry) {
+					continue
+				}
+				printEmbedderDiff(existing, entry)
+				updated++
+				if embedderApplyDryRun {
+					continue
+				}
+				if err := updateEmbedderFromManifest(client, existing, entry); err != nil {
+					return fmt.Errorf("error updating embedder %q: %w", entry.DisplayName, err)
+				}
+			} else {
+				fmt.Printf("create: %s\n", entry.DisplayName)
+				created++
+				if embedderApplyDryRun {
+					continue
+				}
+				if err := createEmbedderFromManifest(client, entry); err != nil {
+					return fmt.Errorf("error creating embedder %q: %w", entry.DisplayName, err)
+				}
+			}
+		}
+
+		if embedderApplyPrune {
+			for idStr, embedder := range existingByID {
+				if seenIDs[idStr] {
+					continue
+				}
+				if !embedderInPruneScope(embedder, embedderApplyOwner, pruneScope) {
+					continue
+				}
+				fmt.Printf("delete: %s\n", embedder.DisplayName)
+				deleted++
+				if embedderApplyDryRun {
+					continue
+				}
+				if err := deleteEmbedderByID(client, embedder.EmbedderId); err != nil {
+					return fmt.Errorf("error deleting embedder %q: %w", embedder.DisplayName, err)
+				}
+			}
+		}
+
+		if embedderApplyDryRun {
+			fmt.Printf("\nDry run: %d to create, %d to update, %d to delete\n", created, updated, deleted)
+		} else {
+			fmt.Printf("\n%d created, %d updated, %d deleted\n", created, updated, deleted)
+		}
+		return nil
+	},
+}
+
+// embedderMatchesManifest reports whether an existing embedder already
+// matches the manifest entry, so apply can skip a no-op update. Credentials
+// are excluded from the comparison since the server never returns them.
+func embedderMatchesManifest(existing *v1.Embedder, entry embedderManifestEntry) bool {
+	// Description, ApiPath, MaxSequenceLength, Version, and MonitoringEndpoint
+	// are omitempty in the manifest schema: a zero value means "not specified
+	// in this document", not "clear the existing value", so it's never drift
+	// on its own. This mirrors the guard updateEmbedderFromManifest applies
+	// before sending these fields.
+	if entry.Description != "" && existing.Description != entry.Description {
+		return false
+	}
+	if existing.EndpointUrl != entry.EndpointURL {
+		return false
+	}
+	if entry.ApiPath != "" && existing.ApiPath != entry.ApiPath {
+		return false
+	}
+	if existing.ModelIdentifier != entry.ModelIdentifier {
+		return false
+	}
+	if existing.Dimensionality != entry.Dimensionality {
+		return false
+	}
+	if entry.MaxSequenceLength > 0 {
+		var existingMaxSeqLen int32
+		if existing.MaxSequenceLength != nil {
+			existingMaxSeqLen = *existing.MaxSequenceLength
+		}
+		if existingMaxSeqLen != entry.MaxSequenceLength {
+			return false
+		}
+	}
+	if entry.Version != "" && existing.Version != entry.Version {
+		return false
+	}
+	if entry.MonitoringEndpoint != "" && existing.MonitoringEndpoint != entry.MonitoringEndpoint {
+		return false
+	}
+	if !modalitiesMatchManifest(existing.SupportedModalities, entry.SupportedModalities) {
+		return false
+	}
+	if len(existing.Labels) != len(entry.Labels) {
+		return false
+	}
+	for k, v := range entry.Labels {
+		if existing.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// modalitiesMatchManifest compares an embedder's SupportedModalities against
+// a manifest entry's string list as sets, ignoring order, so apply doesn't
+// report drift from differing server-side ordering alone.
+func modalitiesMatchManifest(existing []v1.Modality, entryModalities []string) bool {
+	if len(existing) != len(entryModalities) {
+		return false
+	}
+	want := make(map[string]bool, len(entryModalities))
+	for _, m := range entryModalities {
+		want[strings.ToUpper(m)] = true
+	}
+	for _, m := range existing {
+		name := strings.TrimPrefix(m.String(), "MODALITY_")
+		if !want[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// printEmbedderDiff prints a field-level diff between an existing embedder
+// and the manifest entry it's drifted from, so --dry-run shows what would
+// actually change instead of just an "update: <name>" label.
+func printEmbedderDiff(existing *v1.Embedder, entry embedderManifestEntry) {
+	fmt.Printf("update: %s\n", entry.DisplayName)
+	diffField := func(field string, before, after interface{}) {
+		if fmt.Sprint(before) == fmt.Sprint(after) {
+			return
+		}
+		fmt.Printf("  %s: %v -> %v\n", field, before, after)
+	}
+	if entry.Description != "" {
+		diffField("description", existing.Description, entry.Description)
+	}
+	diffField("endpoint_url", existing.EndpointUrl, entry.EndpointURL)
+	if entry.ApiPath != "" {
+		diffField("api_path", existing.ApiPath, entry.ApiPath)
+	}
+	diffField("model_identifier", existing.ModelIdentifier, entry.ModelIdentifier)
+	diffField("dimensionality", existing.Dimensionality, entry.Dimensionality)
+	if entry.MaxSequenceLength > 0 {
+		var existingMaxSeqLen int32
+		if existing.MaxSequenceLength != nil {
+			existingMaxSeqLen = *existing.MaxSequenceLength
+		}
+		diffField("max_sequence_length", existingMaxSeqLen, entry.MaxSequenceLength)
+	}
+	if entry.Version != "" {
+		diffField("version", existing.Version, entry.Version)
+	}
+	if entry.MonitoringEndpoint != "" {
+		diffField("monitoring_endpoint", existing.MonitoringEndpoint, entry.MonitoringEndpoint)
+	}
+	if !modalitiesMatchManifest(existing.SupportedModalities, entry.SupportedModalities) {
+		diffField("supported_modalities", existing.SupportedModalities, entry.SupportedModalities)
+	}
+	if len(existing.Labels) != len(entry.Labels) || !labelsEqual(existing.Labels, entry.Labels) {
+		diffField("labels", existing.Labels, entry.Labels)
+	}
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLabelSelector parses a "--selector" value of the form "key=value" (a
+// single equality match), returning a nil map when selector is empty so
+// embedderInPruneScope treats it as "no label constraint".
+func parseLabelSelector(selector string) (map[string]string, error) {
+	if selector == "" {
+		return nil, nil
+	}
+	key, value, ok := strings.Cut(selector, "=")
+	if !ok {
+		return nil, fmt.Errorf("invalid --selector %q: expected <key>=<value>", selector)
+	}
+	return map[string]string{key: value}, nil
+}
+
+// embedderInPruneScope reports whether an embedder falls within the scope
+// --prune is allowed to delete from: matching --owner (if set) and every
+// label in the --selector (if set). An embedder outside the scope is left
+// alone even if it's absent from the manifest.
+func embedderInPruneScope(embedder *v1.Embedder, ownerFilter string, labelSelector map[string]string) bool {
+	if ownerFilter != "" {
+		ownerIDStr, _ := uuidBytesToString(embedder.OwnerId)
+		if ownerIDStr != ownerFilter {
+			return false
+		}
+	}
+	for k, v := range labelSelector {
+		if embedder.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func createEmbedderFromManifest(client v1connect.EmbedderServiceClient, entry embedderManifestEntry) error {
+	protoProviderType, err := parseProviderType(entry.ProviderType)
+	if err != nil {
+		return err
+	}
+
+	credentials, err := resolveCredentialValue(entry.Credentials)
+	if err != nil {
+		return err
+	}
+
+	var modalities []v1.Modality
+	if len(entry.SupportedModalities) > 0 {
+		modalities, err = parseModalities(entry.SupportedModalities)
+		if err != nil {
+			return err
+		}
+	}
+
+	createReq := &v1.CreateEmbedderRequest{
+		DisplayName:         entry.DisplayName,
+		Description:         entry.Description,
+		ProviderType:        protoProviderType,
+		EndpointUrl:         entry.EndpointURL,
+		ApiPath:             entry.ApiPath,
+		ModelIdentifier:     entry.ModelIdentifier,
+		Dimensionality:      entry.Dimensionality,
+		SupportedModalities: modalities,
+		Credentials:         credentials,
+		Labels:              entry.Labels,
+		Version:             entry.Version,
+		MonitoringEndpoint:  entry.MonitoringEndpoint,
+	}
+	if entry.MaxSequenceLength > 0 {
+		createReq.MaxSequenceLength = &entry.MaxSequenceLength
+	}
+	if entry.Owner != "" {
+		ownerID, err := uuidStringToBytes(entry.Owner)
+		if err != nil {
+			return fmt.Errorf("invalid owner: %w", err)
+		}
+		createReq.OwnerId = ownerID
+	}
+
+	req := connect.NewRequest(createReq)
+	if err := addAuthHeader(req); err != nil {
+		return err
+	}
+	_, err = client.CreateEmbedder(context.Background(), req)
+	if err != nil {
+		return unwrapConnectError(err)
+	}
+	return nil
+}
+
+func updateEmbedderFromManifest(client v1connect.EmbedderServiceClient, existing *v1.Embedder, entry embedderManifestEntry) error {
+	updateReq := &v1.UpdateEmbedderRequest{
+		EmbedderId:      existing.EmbedderId,
+		EndpointUrl:     &entry.EndpointURL,
+		ModelIdentifier: &entry.ModelIdentifier,
+		Dimensionality:  &entry.Dimensionality,
+	}
+
+	// Description, ApiPath, MaxSequenceLength, Version, and MonitoringEndpoint
+	// are omitempty in the manifest schema, so a zero value here may just mean
+	// "not specified in this document" rather than "clear the existing value".
+	// Only send them when set, the same guard createEmbedderFromManifest
+	// already applies to MaxSequenceLength.
+	if entry.Description != "" {
+		updateReq.Description = &entry.Description
+	}
+	if entry.ApiPath != "" {
+		updateReq.ApiPath = &entry.ApiPath
+	}
+	if entry.MaxSequenceLength > 0 {
+		updateReq.MaxSequenceLength = &entry.MaxSequenceLength
+	}
+	if entry.Version != "" {
+		updateReq.Version = &entry.Version
+	}
+	if entry.MonitoringEndpoint != "" {
+		updateReq.MonitoringEndpoint = &entry.MonitoringEndpoint
+	}
+
+	if entry.Credentials != "" {
+		credentials, err := resolveCredentialValue(entry.Credentials)
+		if err != nil {
+			return err
+		}
+		updateReq.Credentials = &credentials
+	}
+
+	if len(entry.SupportedModalities) > 0 {
+		modalities, err := parseModalities(entry.SupportedModalities)
+		if err != nil {
+			return err
+		}
+		updateReq.SupportedModalities = modalities
+	}
+
+	stringMap := &v1.StringMap{Labels: entry.Labels}
+	strategy := strings.ToLower(entry.LabelStrategy)
+	if strategy == "" {
+		strategy = "replace"
+	}
+	switch strategy {
+	case "merge":
+		updateReq.LabelUpdateStrategy = &v1.UpdateEmbedderRequest_MergeLabels{MergeLabels: stringMap}
+	case "replace":
+		updateReq.LabelUpdateStrategy = &v1.UpdateEmbedderRequest_ReplaceLabels{ReplaceLabels: stringMap}
+	default:
+		return fmt.Errorf("invalid label_strategy %q for embedder %q (use 'replace' or 'merge')", entry.LabelStrategy, entry.DisplayName)
+	}
+
+	req := connect.NewRequest(updateReq)
+	if err := addAuthHeader(req); err != nil {
+		return err
+	}
+	_, err := client.UpdateEmbedder(context.Background(), req)
+	if err != nil {
+		return unwrapConnectError(err)
+	}
+	return nil
+}
+
+func deleteEmbedderByID(client v1connect.EmbedderServiceClient, embedderID []byte) error {
+	req := connect.NewRequest(&v1.DeleteEmbedderRequest{EmbedderId: embedderID})
+	if err := addAuthHeader(req); err != nil {
+		return err
+	}
+	_, err := client.DeleteEmbedder(context.Background(), req)
+	if err != nil {
+		return unwrapConnectError(err)
+	}
+	return nil
+}
+
+// readEmbedderManifest loads an embedder manifest from path (or, when path
+// is "-", from stdin), parsing it with the YAML decoder only; JSON manifests
+// are accepted because a JSON document is also valid YAML. Both a bare array
+// of entries and a "kind: EmbedderList" wrapper document are accepted.
+func readEmbedderManifest(path string) ([]embedderManifestEntry, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("error reading manifest from stdin: %w", err)
+		}
+	} else {
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", path, err)
+		}
+	}
+
+	var doc embedderManifestDocument
+	if err := yaml.Unmarshal(data, &doc); err == nil && doc.Kind != "" {
+		if doc.Kind != "EmbedderList" {
+			return nil, fmt.Errorf("unsupported manifest kind %q (expected EmbedderList)", doc.Kind)
+		}
+		return doc.Items, nil
+	}
+
+	var entries []embedderManifestEntry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("error parsing manifest: %w", err)
+	}
+	return entries, nil
+}
+
+// exportEmbeddersCmd represents the export command
+var exportEmbeddersCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export embedders as a declarative manifest",
+	Long: `Lists embedders from the server and writes them as a YAML manifest
+suitable for 'embedder apply -f'. Credentials are never returned by the API,
+so the exported manifest's credentials field is left blank for each
+embedder; fill it in with an "env:VAR", "file:/path", or similar reference
+(see 'embedder credentials test') before applying the manifest elsewhere.`,
+	Example: `  # Export every embedder you own to a file
+  goodmem embedder export -o embedders.yaml
+
+  # Export embedders owned by a specific user
+  goodmem embedder export --owner 123e4567-e89b-12d3-a456-426614174000 -o embedders.yaml`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewEmbedderServiceClient(
+			httpClient,
+			serverAddress,
+			connectClientOptions()...,
+		)
+
+		reqMsg := &v1.ListEmbeddersRequest{}
+		if cmd.Flags().Changed("owner") {
+			ownerID, err := uuidStringToBytes(ownerIDStr)
+			if err != nil {
+				return fmt.Errorf("invalid owner ID: %w", err)
+			}
+			reqMsg.OwnerId = ownerID
+		}
+
+		req := connect.NewRequest(reqMsg)
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+		resp, err := client.ListEmbedders(context.Background(), req)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		entries := make([]embedderManifestEntry, 0, len(resp.Msg.Embedders))
+		for _, embedder := range resp.Msg.Embedders {
+			ownerIDStr, _ := uuidBytesToString(embedder.OwnerId)
+
+			modalities := make([]string, 0, len(embedder.SupportedModalities))
+			for _, m := range embedder.SupportedModalities {
+				modalities = append(modalities, strings.TrimPrefix(m.String(), "MODALITY_"))
+			}
+
+			var maxSeqLen int32
+			if embedder.MaxSequenceLength != nil {
+				maxSeqLen = *embedder.MaxSequenceLength
+			}
+
+			entries = append(entries, embedderManifestEntry{
+				ID:                  formatUUID(embedder.EmbedderId),
+				DisplayName:         embedder.DisplayName,
+				Description:         embedder.Description,
+				ProviderType:        strings.TrimPrefix(embedder.ProviderType.String(), "PROVIDER_TYPE_"),
+				EndpointURL:         embedder.EndpointUrl,
+				ApiPath:             embedder.ApiPath,
+				ModelIdentifier:     embedder.ModelIdentifier,
+				Dimensionality:      embedder.Dimensionality,
+				MaxSequenceLength:   maxSeqLen,
+				SupportedModalities: modalities,
+				Labels:              embedder.Labels,
+				Version:             embedder.Version,
+				MonitoringEndpoint:  embedder.MonitoringEndpoint,
+				Owner:               ownerIDStr,
+			})
+		}
+
+		data, err := yaml.Marshal(entries)
+		if err != nil {
+			return fmt.Errorf("error encoding manifest: %w", err)
+		}
+
+		if embedderExportOutput == "" || embedderExportOutput == "-" {
+			fmt.Print(string(data))
+			return nil
+		}
+		if err := os.WriteFile(embedderExportOutput, data, 0644); err != nil {
+			return fmt.Errorf("error writing %s: %w", embedderExportOutput, err)
+		}
+		fmt.Printf("Exported %d embedders to %s\n", len(entries), embedderExportOutput)
+		return nil
+	},
+}
+
+func init() {
+	embedderCmd.AddCommand(applyEmbeddersCmd)
+	embedderCmd.AddCommand(exportEmbeddersCmd)
+
+	applyEmbeddersCmd.Flags().StringVarP(&embedderApplyFile, "file", "f", "", "Path to a YAML or JSON manifest of the desired embedders, or - for stdin (required)")
+	applyEmbeddersCmd.Flags().BoolVar(&embedderApplyDryRun, "dry-run", false, "Print the actions (and field-level diffs) that would be taken without making any changes")
+	applyEmbeddersCmd.Flags().BoolVar(&embedderApplyPrune, "prune", false, "Delete embedders present on the server but absent from the manifest")
+	applyEmbeddersCmd.Flags().StringVar(&embedderApplyOwner, "owner", "", "Scope --prune to embedders owned by this user ID (UUID)")
+	applyEmbeddersCmd.Flags().StringVar(&embedderApplySelector, "selector", "", "Scope --prune to embedders matching this label (key=value)")
+
+	exportEmbeddersCmd.Flags().StringVarP(&embedderExportOutput, "output", "o", "", "Path to write the manifest to (defaults to stdout)")
+	exportEmbeddersCmd.Flags().StringVar(&ownerIDStr, "owner", "", "Only export embedders owned by this user ID (UUID)")
+}
@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"testing"
+
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+)
+
+func int32Ptr(v int32) *int32 { return &v }
+
+func TestEmbedderMatchesManifest(t *testing.T) {
+	base := func() *v1.Embedder {
+		return &v1.Embedder{
+			Description:         "desc",
+			EndpointUrl:         "https://api.example.com",
+			ApiPath:             "/v1/embeddings",
+			ModelIdentifier:     "text-embedding-3-small",
+			Dimensionality:      1536,
+			MaxSequenceLength:   int32Ptr(8192),
+			Version:             "1.0.0",
+			MonitoringEndpoint:  "https://metrics.example.com",
+			SupportedModalities: []v1.Modality{v1.Modality_MODALITY_TEXT},
+			Labels:              map[string]string{"env": "prod"},
+		}
+	}
+	baseEntry := func() embedderManifestEntry {
+		return embedderManifestEntry{
+			Description:         "desc",
+			EndpointURL:         "https://api.example.com",
+			ApiPath:             "/v1/embeddings",
+			ModelIdentifier:     "text-embedding-3-small",
+			Dimensionality:      1536,
+			MaxSequenceLength:   8192,
+			Version:             "1.0.0",
+			MonitoringEndpoint:  "https://metrics.example.com",
+			SupportedModalities: []string{"text"},
+			Labels:              map[string]string{"env": "prod"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(*v1.Embedder, *embedderManifestEntry)
+		wantSame bool
+	}{
+		{name: "identical entries match", wantSame: true},
+		{
+			name: "differing max_sequence_length is drift",
+			mutate: func(e *v1.Embedder, entry *embedderManifestEntry) {
+				entry.MaxSequenceLength = 4096
+			},
+			wantSame: false,
+		},
+		{
+			name: "nil max_sequence_length on server compares as zero",
+			mutate: func(e *v1.Embedder, entry *embedderManifestEntry) {
+				e.MaxSequenceLength = nil
+				entry.MaxSequenceLength = 0
+			},
+			wantSame: true,
+		},
+		{
+			name: "differing version is drift",
+			mutate: func(e *v1.Embedder, entry *embedderManifestEntry) {
+				entry.Version = "2.0.0"
+			},
+			wantSame: false,
+		},
+		{
+			name: "differing monitoring_endpoint is drift",
+			mutate: func(e *v1.Embedder, entry *embedderManifestEntry) {
+				entry.MonitoringEndpoint = "https://metrics2.example.com"
+			},
+			wantSame: false,
+		},
+		{
+			name: "reordered modalities are not drift",
+			mutate: func(e *v1.Embedder, entry *embedderManifestEntry) {
+				e.SupportedModalities = []v1.Modality{v1.Modality_MODALITY_IMAGE, v1.Modality_MODALITY_TEXT}
+				entry.SupportedModalities = []string{"text", "image"}
+			},
+			wantSame: true,
+		},
+		{
+			name: "missing modality is drift",
+			mutate: func(e *v1.Embedder, entry *embedderManifestEntry) {
+				entry.SupportedModalities = []string{"text", "image"}
+			},
+			wantSame: false,
+		},
+		{
+			name: "differing label value is drift",
+			mutate: func(e *v1.Embedder, entry *embedderManifestEntry) {
+				entry.Labels = map[string]string{"env": "staging"}
+			},
+			wantSame: false,
+		},
+		{
+			name: "omitted optional fields on the manifest are not drift",
+			mutate: func(e *v1.Embedder, entry *embedderManifestEntry) {
+				entry.Description = ""
+				entry.ApiPath = ""
+				entry.MaxSequenceLength = 0
+				entry.Version = ""
+				entry.MonitoringEndpoint = ""
+			},
+			wantSame: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := base()
+			entry := baseEntry()
+			if tt.mutate != nil {
+				tt.mutate(existing, &entry)
+			}
+			if got := embedderMatchesManifest(existing, entry); got != tt.wantSame {
+				t.Errorf("embedderMatchesManifest() = %v, want %v", got, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestModalitiesMatchManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []v1.Modality
+		entry    []string
+		want     bool
+	}{
+		{name: "both empty", existing: nil, entry: nil, want: true},
+		{
+			name:     "same set, different order",
+			existing: []v1.Modality{v1.Modality_MODALITY_TEXT, v1.Modality_MODALITY_AUDIO},
+			entry:    []string{"audio", "text"},
+			want:     true,
+		},
+		{
+			name:     "case-insensitive match",
+			existing: []v1.Modality{v1.Modality_MODALITY_VIDEO},
+			entry:    []string{"VIDEO"},
+			want:     true,
+		},
+		{
+			name:     "different lengths",
+			existing: []v1.Modality{v1.Modality_MODALITY_TEXT},
+			entry:    []string{"text", "image"},
+			want:     false,
+		},
+		{
+			name:     "same length, different members",
+			existing: []v1.Modality{v1.Modality_MODALITY_TEXT},
+			entry:    []string{"image"},
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := modalitiesMatchManifest(tt.existing, tt.entry); got != tt.want {
+				t.Errorf("modalitiesMatchManifest(%v, %v) = %v, want %v", tt.existing, tt.entry, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Run("empty selector returns nil map", func(t *testing.T) {
+		got, err := parseLabelSelector("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != nil {
+			t.Errorf("parseLabelSelector(\"\") = %v, want nil", got)
+		}
+	})
+
+	t.Run("key=value selector", func(t *testing.T) {
+		got, err := parseLabelSelector("env=prod")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := map[string]string{"env": "prod"}
+		if len(got) != len(want) || got["env"] != want["env"] {
+			t.Errorf("parseLabelSelector(\"env=prod\") = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("missing equals sign is an error", func(t *testing.T) {
+		if _, err := parseLabelSelector("env"); err == nil {
+			t.Fatal("expected error for selector without '=', got nil")
+		}
+	})
+}
+
+func TestEmbedderInPruneScope(t *testing.T) {
+	embedder := &v1.Embedder{
+		Labels: map[string]string{"env": "prod"},
+	}
+
+	tests := []struct {
+		name          string
+		ownerFilter   string
+		labelSelector map[string]string
+		want          bool
+	}{
+		{name: "no scope matches everything", want: true},
+		{name: "matching label selector", labelSelector: map[string]string{"env": "prod"}, want: true},
+		{name: "non-matching label selector", labelSelector: map[string]string{"env": "staging"}, want: false},
+		{name: "owner filter excludes embedder with no owner match", ownerFilter: "123e4567-e89b-12d3-a456-426614174000", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := embedderInPruneScope(embedder, tt.ownerFilter, tt.labelSelector); got != tt.want {
+				t.Errorf("embedderInPruneScope() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
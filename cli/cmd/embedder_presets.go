@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+var createPreset string
+
+// embedderPreset pre-fills the flags createEmbedderCmd would otherwise
+// require by hand for a well-known embedding backend: provider-type,
+// endpoint-url, api-path, model-identifier, dimensionality,
+// max-sequence-length, and modality. applyEmbedderPreset only Set()s a flag
+// the user hasn't already Changed, so an explicit flag always overrides the
+// preset's value.
+type embedderPreset struct {
+	ProviderType      string
+	EndpointURL       string
+	ApiPath           string
+	ModelIdentifier   string
+	Dimensionality    int32
+	MaxSequenceLength int32
+	Modalities        []string
+	// Unsupported explains why --preset refuses to apply this entry. It's
+	// set for providers goodmem has no ProviderType value or server-side
+	// adapter for yet, so 'presets list' documents the gap instead of
+	// silently producing an embedder that can never actually serve a
+	// request.
+	Unsupported string
+}
+
+// embedderPresets maps a "provider/model" key to its preset. Cohere, AWS
+// Bedrock, and the HuggingFace Inference API are listed but marked
+// Unsupported: their wire formats (SigV4-signed requests, a non-OpenAI
+// response shape, ...) don't match what goodmem's OPENAI/VLLM/TEI
+// ProviderType values and server-side adapters know how to call, so wiring
+// them up for real requires a proto change and a new server adapter, not
+// just a CLI preset.
+var embedderPresets = map[string]embedderPreset{
+	"openai/text-embedding-3-small": {
+		ProviderType:      "OPENAI",
+		EndpointURL:       "https://api.openai.com",
+		ApiPath:           "/v1/embeddings",
+		ModelIdentifier:   "text-embedding-3-small",
+		Dimensionality:    1536,
+		MaxSequenceLength: 8191,
+		Modalities:        []string{"TEXT"},
+	},
+	"openai/text-embedding-3-large": {
+		ProviderType:      "OPENAI",
+		EndpointURL:       "https://api.openai.com",
+		ApiPath:           "/v1/embeddings",
+		ModelIdentifier:   "text-embedding-3-large",
+		Dimensionality:    3072,
+		MaxSequenceLength: 8191,
+		Modalities:        []string{"TEXT"},
+	},
+	"openai/text-embedding-ada-002": {
+		ProviderType:      "OPENAI",
+		EndpointURL:       "https://api.openai.com",
+		ApiPath:           "/v1/embeddings",
+		ModelIdentifier:   "text-embedding-ada-002",
+		Dimensionality:    1536,
+		MaxSequenceLength: 8191,
+		Modalities:        []string{"TEXT"},
+	},
+	"ollama/nomic-embed-text": {
+		// Ollama exposes an OpenAI-compatible /v1/embeddings route, so this
+		// one is a real OPENAI-providerType preset rather than a distinct
+		// provider integration.
+		ProviderType:      "OPENAI",
+		EndpointURL:       "http://localhost:11434",
+		ApiPath:           "/v1/embeddings",
+		ModelIdentifier:   "nomic-embed-text",
+		Dimensionality:    768,
+		MaxSequenceLength: 8192,
+		Modalities:        []string{"TEXT"},
+	},
+	"cohere/embed-english-v3": {
+		ProviderType:      "COHERE",
+		ModelIdentifier:   "embed-english-v3.0",
+		Dimensionality:    1024,
+		MaxSequenceLength: 512,
+		Modalities:        []string{"TEXT"},
+		Unsupported:       "Cohere's embed API isn't OpenAI-request-compatible; goodmem has no COHERE ProviderType or server adapter yet",
+	},
+	"bedrock/titan-embed-text-v2": {
+		ProviderType:      "BEDROCK",
+		ModelIdentifier:   "amazon.titan-embed-text-v2:0",
+		Dimensionality:    1024,
+		MaxSequenceLength: 8192,
+		Modalities:        []string{"TEXT"},
+		Unsupported:       "AWS Bedrock requires SigV4-signed requests; goodmem has no BEDROCK ProviderType or server adapter yet",
+	},
+	"hf-inference/BAAI/bge-large-en-v1.5": {
+		ProviderType:      "HUGGINGFACE",
+		ModelIdentifier:   "BAAI/bge-large-en-v1.5",
+		Dimensionality:    1024,
+		MaxSequenceLength: 512,
+		Modalities:        []string{"TEXT"},
+		Unsupported:       "the HuggingFace Inference API's response shape differs from TEI's; goodmem has no adapter for it yet",
+	},
+}
+
+// presetKeys returns the registered preset keys in a stable, sorted order.
+func presetKeys() []string {
+	keys := make([]string, 0, len(embedderPresets))
+	for key := range embedderPresets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// applyEmbedderPreset pre-fills createEmbedderCmd's flags from the named
+// preset, skipping any flag the caller already set explicitly so
+// --preset ... --dimensionality 999 still honors the explicit override.
+func applyEmbedderPreset(cmd *cobra.Command, name string) error {
+	preset, ok := embedderPresets[name]
+	if !ok {
+		return fmt.Errorf("unknown preset %q (see 'goodmem embedder presets list')", name)
+	}
+	if preset.Unsupported != "" {
+		return fmt.Errorf("preset %q isn't usable yet: %s", name, preset.Unsupported)
+	}
+
+	set := func(flag, value string) error {
+		if value == "" || cmd.Flags().Changed(flag) {
+			return nil
+		}
+		return cmd.Flags().Set(flag, value)
+	}
+
+	if err := set("provider-type", preset.ProviderType); err != nil {
+		return err
+	}
+	if err := set("endpoint-url", preset.EndpointURL); err != nil {
+		return err
+	}
+	if err := set("api-path", preset.ApiPath); err != nil {
+		return err
+	}
+	if err := set("model-identifier", preset.ModelIdentifier); err != nil {
+		return err
+	}
+	if preset.Dimensionality > 0 {
+		if err := set("dimensionality", strconv.Itoa(int(preset.Dimensionality))); err != nil {
+			return err
+		}
+	}
+	if preset.MaxSequenceLength > 0 {
+		if err := set("max-sequence-length", strconv.Itoa(int(preset.MaxSequenceLength))); err != nil {
+			return err
+		}
+	}
+	if len(preset.Modalities) > 0 && !cmd.Flags().Changed("modality") {
+		for _, modality := range preset.Modalities {
+			if err := cmd.Flags().Set("modality", modality); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// embedderPresetsCmd groups preset-inspection commands.
+var embedderPresetsCmd = &cobra.Command{
+	Use:   "presets",
+	Short: "Inspect built-in provider/model presets for 'embedder create'",
+}
+
+// presetsListCmd represents the presets list command
+var presetsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the presets available for --preset",
+	Long: `Lists every preset 'embedder create --preset <name>' can pre-fill
+provider-type, endpoint-url, api-path, model-identifier, dimensionality,
+max-sequence-length, and modality from. Presets marked unsupported are
+documented for visibility but refused by --preset, since goodmem has no
+ProviderType value or server adapter for that backend yet.`,
+	Example: `  goodmem embedder presets list`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		fmt.Printf("%-40s %-12s %-30s %-6s %s\n", "PRESET", "PROVIDER", "MODEL", "DIMS", "STATUS")
+		for _, key := range presetKeys() {
+			preset := embedderPresets[key]
+			status := "ok"
+			if preset.Unsupported != "" {
+				status = "unsupported: " + preset.Unsupported
+			}
+			fmt.Printf("%-40s %-12s %-30s %-6d %s\n", key, preset.ProviderType, preset.ModelIdentifier, preset.Dimensionality, status)
+		}
+		return nil
+	},
+}
+
+func init() {
+	embedderCmd.AddCommand(embedderPresetsCmd)
+	embedderPresetsCmd.AddCommand(presetsListCmd)
+
+	createEmbedderCmd.Flags().StringVar(&createPreset, "preset", "", "Pre-fill provider/model defaults from a built-in preset (see 'embedder presets list'); explicit flags still override")
+}
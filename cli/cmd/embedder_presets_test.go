@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// newTestCreateEmbedderCmd builds a standalone command with the same flags
+// createEmbedderCmd registers, so applyEmbedderPreset can be exercised
+// without mutating the package's real createEmbedderCmd/global flag vars.
+func newTestCreateEmbedderCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "create"}
+	cmd.Flags().String("provider-type", "", "")
+	cmd.Flags().String("endpoint-url", "", "")
+	cmd.Flags().String("api-path", "", "")
+	cmd.Flags().String("model-identifier", "", "")
+	cmd.Flags().Int32("dimensionality", 0, "")
+	cmd.Flags().Int32("max-sequence-length", 0, "")
+	cmd.Flags().StringSlice("modality", []string{}, "")
+	return cmd
+}
+
+func TestApplyEmbedderPreset(t *testing.T) {
+	t.Run("unknown preset is an error", func(t *testing.T) {
+		cmd := newTestCreateEmbedderCmd()
+		if err := applyEmbedderPreset(cmd, "nonexistent/preset"); err == nil {
+			t.Fatal("expected error for unknown preset, got nil")
+		}
+	})
+
+	t.Run("unsupported preset is refused", func(t *testing.T) {
+		cmd := newTestCreateEmbedderCmd()
+		if err := applyEmbedderPreset(cmd, "cohere/embed-english-v3"); err == nil {
+			t.Fatal("expected error for unsupported preset, got nil")
+		}
+	})
+
+	t.Run("known preset fills every flag", func(t *testing.T) {
+		cmd := newTestCreateEmbedderCmd()
+		if err := applyEmbedderPreset(cmd, "openai/text-embedding-3-small"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		providerType, _ := cmd.Flags().GetString("provider-type")
+		if providerType != "OPENAI" {
+			t.Errorf("provider-type = %q, want %q", providerType, "OPENAI")
+		}
+		endpointURL, _ := cmd.Flags().GetString("endpoint-url")
+		if endpointURL != "https://api.openai.com" {
+			t.Errorf("endpoint-url = %q, want %q", endpointURL, "https://api.openai.com")
+		}
+		apiPath, _ := cmd.Flags().GetString("api-path")
+		if apiPath != "/v1/embeddings" {
+			t.Errorf("api-path = %q, want %q", apiPath, "/v1/embeddings")
+		}
+		modelIdentifier, _ := cmd.Flags().GetString("model-identifier")
+		if modelIdentifier != "text-embedding-3-small" {
+			t.Errorf("model-identifier = %q, want %q", modelIdentifier, "text-embedding-3-small")
+		}
+		dimensionality, _ := cmd.Flags().GetInt32("dimensionality")
+		if dimensionality != 1536 {
+			t.Errorf("dimensionality = %d, want 1536", dimensionality)
+		}
+		maxSequenceLength, _ := cmd.Flags().GetInt32("max-sequence-length")
+		if maxSequenceLength != 8191 {
+			t.Errorf("max-sequence-length = %d, want 8191", maxSequenceLength)
+		}
+		modalities, _ := cmd.Flags().GetStringSlice("modality")
+		if len(modalities) != 1 || modalities[0] != "TEXT" {
+			t.Errorf("modality = %v, want [TEXT]", modalities)
+		}
+	})
+
+	t.Run("an explicitly set flag overrides the preset value", func(t *testing.T) {
+		cmd := newTestCreateEmbedderCmd()
+		if err := cmd.Flags().Set("dimensionality", "999"); err != nil {
+			t.Fatalf("failed to set dimensionality: %v", err)
+		}
+
+		if err := applyEmbedderPreset(cmd, "openai/text-embedding-3-small"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		dimensionality, _ := cmd.Flags().GetInt32("dimensionality")
+		if dimensionality != 999 {
+			t.Errorf("dimensionality = %d, want explicit override of 999", dimensionality)
+		}
+		// provider-type wasn't explicitly set, so the preset should still fill it.
+		providerType, _ := cmd.Flags().GetString("provider-type")
+		if providerType != "OPENAI" {
+			t.Errorf("provider-type = %q, want %q", providerType, "OPENAI")
+		}
+	})
+}
+
+func TestPresetKeys(t *testing.T) {
+	keys := presetKeys()
+	if len(keys) != len(embedderPresets) {
+		t.Fatalf("presetKeys() returned %d keys, want %d", len(keys), len(embedderPresets))
+	}
+	for i := 1; i < len(keys); i++ {
+		if keys[i-1] > keys[i] {
+			t.Errorf("presetKeys() is not sorted: %v", keys)
+			break
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+	v1connect "github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
+	"github.com/spf13/cobra"
+)
+
+var (
+	probeAll         bool
+	probeSamples     int
+	probeConcurrency int
+	probeTimeout     time.Duration
+	probeCredentials string
+)
+
+// probeResult is one sample's outcome against an embedder's endpoint.
+type probeResult struct {
+	latency time.Duration
+	err     error
+	class   string // "", "auth", "tls", "network", "shape"
+}
+
+// probeEmbedderCmd represents the probe command
+var probeEmbedderCmd = &cobra.Command{
+	Use:   "probe [embedder-id]",
+	Short: "Actively health-check an embedder's endpoint",
+	Long: `Issues a minimal text embedding request against the embedder's configured
+endpoint and model, and checks that the returned vector length matches the
+embedder's declared Dimensionality. Reports latency percentiles across
+--samples requests and exits non-zero on failure, so it can be wired into CI
+readiness checks.
+
+Only the TEXT modality is exercised: every request sends a short text input,
+regardless of what the embedder's SupportedModalities declares. An embedder
+declaring IMAGE, AUDIO, or VIDEO support is probed the same way, and a probe
+"OK" says nothing about whether those other modalities actually work.`,
+	Example: `  # Probe a single embedder
+  goodmem embedder probe 123e4567-e89b-12d3-a456-426614174000
+
+  # Probe every embedder with 10 samples at concurrency 4
+  goodmem embedder probe --all --samples 10 --concurrency 4`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		if !probeAll && len(args) != 1 {
+			return fmt.Errorf("provide an embedder ID, or pass --all to probe every embedder")
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewEmbedderServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		var targets []*v1.Embedder
+		if probeAll {
+			req := connect.NewRequest(&v1.ListEmbeddersRequest{})
+			if err := addAuthHeader(req); err != nil {
+				return err
+			}
+			resp, err := client.ListEmbedders(context.Background(), req)
+			if err != nil {
+				return unwrapConnectError(err)
+			}
+			targets = resp.Msg.Embedders
+		} else {
+			embedderID, err := uuidStringToBytes(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid embedder ID: %w", err)
+			}
+			req := connect.NewRequest(&v1.GetEmbedderRequest{EmbedderId: embedderID})
+			if err := addAuthHeader(req); err != nil {
+				return err
+			}
+			resp, err := client.GetEmbedder(context.Background(), req)
+			if err != nil {
+				return unwrapConnectError(err)
+			}
+			targets = []*v1.Embedder{resp.Msg}
+		}
+
+		anyFailed := false
+		for _, embedder := range targets {
+			ok := probeOneEmbedder(embedder)
+			if !ok {
+				anyFailed = true
+			}
+		}
+
+		if anyFailed {
+			return fmt.Errorf("one or more embedder probes failed")
+		}
+		return nil
+	},
+}
+
+func probeOneEmbedder(embedder *v1.Embedder) bool {
+	name := embedder.DisplayName
+	if name == "" {
+		name = formatUUID(embedder.EmbedderId)
+	}
+	fmt.Printf("Probing %s (%s)...\n", name, embedder.ModelIdentifier)
+	if modalities := nonTextModalities(embedder.SupportedModalities); len(modalities) > 0 {
+		fmt.Printf("  note: only TEXT is probed; declared modalities %s are not exercised\n", strings.Join(modalities, ", "))
+	}
+
+	results := make([]probeResult, probeSamples)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	concurrency := probeConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				results[idx] = probeEmbedderOnce(embedder)
+			}
+		}()
+	}
+	for i := 0; i < probeSamples; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var latencies []time.Duration
+	failures := 0
+	classCounts := make(map[string]int)
+
+	for _, r := range results {
+		if r.err != nil {
+			failures++
+			classCounts[r.class]++
+			continue
+		}
+		latencies = append(latencies, r.latency)
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	fmt.Printf("  samples: %d, failures: %d\n", probeSamples, failures)
+	if len(latencies) > 0 {
+		fmt.Printf("  latency p50=%s p95=%s p99=%s\n",
+			percentile(latencies, 50), percentile(latencies, 95), percentile(latencies, 99))
+	}
+	for class, count := range classCounts {
+		label := class
+		if label == "" {
+			label = "unknown"
+		}
+		fmt.Printf("  %d failure(s) classified as %s\n", count, label)
+	}
+
+	if failures > 0 {
+		fmt.Printf("  FAIL: %s\n", name)
+		return false
+	}
+	fmt.Printf("  OK: %s\n", name)
+	return true
+}
+
+// nonTextModalities returns the declared modalities other than TEXT, in
+// declaration order, so probeOneEmbedder can warn that it only validates text.
+func nonTextModalities(modalities []v1.Modality) []string {
+	var others []string
+	for _, m := range modalities {
+		if m == v1.Modality_MODALITY_TEXT {
+			continue
+		}
+		others = append(others, strings.TrimPrefix(m.String(), "MODALITY_"))
+	}
+	return others
+}
+
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := (len(sorted)*p+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// probeEmbedderOnce issues a single minimal embedding request against the
+// embedder's endpoint and validates the response shape.
+func probeEmbedderOnce(embedder *v1.Embedder) probeResult {
+	endpoint, err := probeEndpointURL(embedder)
+	if err != nil {
+		return probeResult{err: err, class: "network"}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"model": embedder.ModelIdentifier,
+		"input": "goodmem embedder probe",
+	})
+	if err != nil {
+		return probeResult{err: err}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return probeResult{err: err, class: "network"}
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if probeCredentials != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+probeCredentials)
+	}
+
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(httpReq)
+	latency := time.Since(start)
+	if err != nil {
+		class := "network"
+		if strings.Contains(err.Error(), "x509") || strings.Contains(err.Error(), "tls") {
+			class = "tls"
+		}
+		return probeResult{err: err, class: class}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return probeResult{err: fmt.Errorf("auth failed: HTTP %d", resp.StatusCode), class: "auth"}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return probeResult{err: fmt.Errorf("HTTP %d", resp.StatusCode), class: "network"}
+	}
+
+	var parsed struct {
+		Data []struct {
+			Embedding []float64 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return probeResult{err: fmt.Errorf("error decoding response: %w", err), class: "shape"}
+	}
+	if len(parsed.Data) == 0 {
+		return probeResult{err: fmt.Errorf("response contained no embeddings"), class: "shape"}
+	}
+
+	got := len(parsed.Data[0].Embedding)
+	want := int(embedder.Dimensionality)
+	if got != want {
+		return probeResult{err: fmt.Errorf("vector length %d does not match declared dimensionality %d", got, want), class: "shape", latency: latency}
+	}
+
+	return probeResult{latency: latency}
+}
+
+// probeEndpointURL joins the embedder's endpoint URL with its API path,
+// defaulting to the OpenAI-compatible /v1/embeddings path when ApiPath is
+// unset (matches the provider types this CLI supports: OPENAI, VLLM, TEI).
+func probeEndpointURL(embedder *v1.Embedder) (string, error) {
+	base, err := url.Parse(embedder.EndpointUrl)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint URL: %w", err)
+	}
+
+	path := embedder.ApiPath
+	if path == "" {
+		path = "/v1/embeddings"
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/") + "/" + strings.TrimPrefix(path, "/")
+
+	return base.String(), nil
+}
+
+func init() {
+	embedderCmd.AddCommand(probeEmbedderCmd)
+
+	probeEmbedderCmd.Flags().BoolVar(&probeAll, "all", false, "Probe every embedder instead of a single ID")
+	probeEmbedderCmd.Flags().IntVar(&probeSamples, "samples", 5, "Number of probe requests to issue per embedder")
+	probeEmbedderCmd.Flags().IntVar(&probeConcurrency, "concurrency", 1, "Number of probe requests to run concurrently per embedder")
+	probeEmbedderCmd.Flags().DurationVar(&probeTimeout, "timeout", 10*time.Second, "Timeout for each probe request")
+	probeEmbedderCmd.Flags().StringVar(&probeCredentials, "credentials", "", "Bearer credential to use against the embedder endpoint (stored credentials are not returned by the API)")
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+)
+
+func TestNonTextModalities(t *testing.T) {
+	tests := []struct {
+		name       string
+		modalities []v1.Modality
+		want       []string
+	}{
+		{name: "no modalities declared", modalities: nil, want: nil},
+		{name: "text only", modalities: []v1.Modality{v1.Modality_MODALITY_TEXT}, want: nil},
+		{
+			name:       "text plus image",
+			modalities: []v1.Modality{v1.Modality_MODALITY_TEXT, v1.Modality_MODALITY_IMAGE},
+			want:       []string{"IMAGE"},
+		},
+		{
+			name:       "audio and video without text",
+			modalities: []v1.Modality{v1.Modality_MODALITY_AUDIO, v1.Modality_MODALITY_VIDEO},
+			want:       []string{"AUDIO", "VIDEO"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nonTextModalities(tt.modalities)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("nonTextModalities(%v) = %v, want %v", tt.modalities, got, tt.want)
+			}
+		})
+	}
+}
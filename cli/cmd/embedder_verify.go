@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+	v1connect "github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
+	"github.com/spf13/cobra"
+)
+
+var (
+	verifySampleText string
+	verifyTimeout    time.Duration
+
+	createVerify bool
+	updateVerify bool
+)
+
+// verifyDiagnostic is the machine-readable result of an embedder endpoint
+// verification, printed as JSON when the probe fails so it can be consumed
+// by scripts/CI.
+type verifyDiagnostic struct {
+	EndpointURL            string `json:"endpoint_url"`
+	ApiPath                string `json:"api_path"`
+	ModelIdentifier        string `json:"model_identifier"`
+	ExpectedDimensionality int32  `json:"expected_dimensionality"`
+	ActualDimensionality   int32  `json:"actual_dimensionality,omitempty"`
+	LatencyMs              int64  `json:"latency_ms,omitempty"`
+	Passed                 bool   `json:"passed"`
+	Error                  string `json:"error,omitempty"`
+}
+
+// verifyEmbedderEndpoint issues a single embedding request against the given
+// endpoint/model and checks that the returned vector length matches
+// dimensionality, so a broken endpoint/credential/dimensionality combination
+// is caught at registration time instead of at ingestion time.
+func verifyEmbedderEndpoint(endpointURL, apiPath, model string, dimensionality int32, sampleText, credentials string, timeout time.Duration) verifyDiagnostic {
+	diag := verifyDiagnostic{
+		EndpointURL:            endpointURL,
+		ApiPath:                apiPath,
+		ModelIdentifier:        model,
+		ExpectedDimensionality: dimensionality,
+	}
+
+	endpoint, err := probeEndpointURL(&v1.Embedder{EndpointUrl: endpointURL, ApiPath: apiPath})
+	if err != nil {
+		diag.Error = err.Error()
+		return diag
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"model": model,
+		"input": sampleText,
+	})
+	if err != nil {
+		diag.Error = err.Error()
+		return diag
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		diag.Error = err.Error()
+		return diag
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if credentials != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+credentials)
+	}
+
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(httpReq)
+	diag.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		diag.Error = err.Error()
+		return diag
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		diag.Error = fmt.Sprintf("HTTP %d", resp.StatusCode)
+		return diag
+	}
+
+	var parsed struct {
+		Data []struct {
+			Embedding []float64 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		diag.Error = fmt.Sprintf("error decoding response: %v", err)
+		return diag
+	}
+	if len(parsed.Data) == 0 {
+		diag.Error = "response contained no embeddings"
+		return diag
+	}
+
+	diag.ActualDimensionality = int32(len(parsed.Data[0].Embedding))
+	if diag.ActualDimensionality != dimensionality {
+		diag.Error = fmt.Sprintf("vector length %d does not match declared dimensionality %d", diag.ActualDimensionality, dimensionality)
+		return diag
+	}
+
+	diag.Passed = true
+	return diag
+}
+
+// reportVerifyDiagnostic prints a human-readable summary, and on failure
+// also prints the diagnostic as JSON so it can be parsed by scripts/CI.
+func reportVerifyDiagnostic(diag verifyDiagnostic) error {
+	if diag.Passed {
+		fmt.Printf("OK: %s (%s) responded in %dms with dimensionality %d\n", diag.ModelIdentifier, diag.EndpointURL, diag.LatencyMs, diag.ActualDimensionality)
+		return nil
+	}
+
+	fmt.Printf("FAIL: %s (%s): %s\n", diag.ModelIdentifier, diag.EndpointURL, diag.Error)
+	data, err := json.Marshal(diag)
+	if err == nil {
+		fmt.Println(string(data))
+	}
+	return fmt.Errorf("embedder verification failed: %s", diag.Error)
+}
+
+// verifyEmbedderCmd represents the verify command
+var verifyEmbedderCmd = &cobra.Command{
+	Use:   "verify [embedder-id]",
+	Short: "Verify an embedder's endpoint responds correctly",
+	Long: `Fetches the embedder's configuration and issues a single embedding
+request with --sample-text, checking that the response arrives within
+--timeout and that its vector length matches the embedder's declared
+Dimensionality. Exits non-zero with a JSON diagnostic on failure, so it can
+be wired into CI or run right after 'embedder create'.`,
+	Example: `  goodmem embedder verify 123e4567-e89b-12d3-a456-426614174000
+  goodmem embedder verify 123e4567-e89b-12d3-a456-426614174000 --sample-text "a longer probe sentence" --timeout 5s`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		embedderID, err := uuidStringToBytes(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid embedder ID: %w", err)
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewEmbedderServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+		req := connect.NewRequest(&v1.GetEmbedderRequest{EmbedderId: embedderID})
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+		resp, err := client.GetEmbedder(context.Background(), req)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+		embedder := resp.Msg
+
+		diag := verifyEmbedderEndpoint(embedder.EndpointUrl, embedder.ApiPath, embedder.ModelIdentifier, embedder.Dimensionality, verifySampleText, probeCredentials, verifyTimeout)
+		return reportVerifyDiagnostic(diag)
+	},
+}
+
+func init() {
+	embedderCmd.AddCommand(verifyEmbedderCmd)
+
+	verifyEmbedderCmd.Flags().StringVar(&verifySampleText, "sample-text", "hello world", "Sample text to embed when verifying")
+	verifyEmbedderCmd.Flags().DurationVar(&verifyTimeout, "timeout", 10*time.Second, "Timeout for the verification request")
+	verifyEmbedderCmd.Flags().StringVar(&probeCredentials, "credentials", "", "Bearer credential to use against the embedder endpoint (stored credentials are not returned by the API)")
+
+	createEmbedderCmd.Flags().BoolVar(&createVerify, "verify", false, "Verify the endpoint responds correctly before registering the embedder")
+	updateEmbedderCmd.Flags().BoolVar(&updateVerify, "verify", false, "Verify the endpoint responds correctly after applying the update")
+}
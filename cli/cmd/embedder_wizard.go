@@ -0,0 +1,354 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var createInteractive bool
+
+// openAIModelDefaults maps well-known OpenAI embedding models to their
+// output dimensionality, so the wizard can skip asking when it already
+// knows the answer.
+var openAIModelDefaults = map[string]int32{
+	"text-embedding-3-small": 1536,
+	"text-embedding-3-large": 3072,
+	"text-embedding-ada-002": 1536,
+}
+
+// runEmbedderWizard interactively collects the fields createEmbedderCmd
+// needs and applies them via cmd.Flags().Set, so the rest of the command's
+// RunE (validation, request building, response rendering) runs unchanged
+// whether its flags came from the wizard or the command line.
+func runEmbedderWizard(cmd *cobra.Command) error {
+	reader := bufio.NewScanner(os.Stdin)
+
+	fmt.Println("GoodMem embedder setup wizard (press Enter to accept a default in [brackets])")
+	fmt.Println()
+
+	name := promptString(reader, "Display name", "")
+	if name == "" {
+		return fmt.Errorf("display name is required")
+	}
+	if err := cmd.Flags().Set("display-name", name); err != nil {
+		return err
+	}
+
+	fmt.Println("\nProvider type:\n  1) OpenAI\n  2) vLLM\n  3) TEI")
+	switch promptString(reader, "Choice", "1") {
+	case "1", "openai", "OPENAI":
+		return wizardOpenAI(cmd, reader)
+	case "2", "vllm", "VLLM":
+		return wizardVLLM(cmd, reader)
+	case "3", "tei", "TEI":
+		return wizardTEI(cmd, reader)
+	default:
+		return fmt.Errorf("unrecognized provider choice")
+	}
+}
+
+func wizardOpenAI(cmd *cobra.Command, reader *bufio.Scanner) error {
+	if err := cmd.Flags().Set("provider-type", "OPENAI"); err != nil {
+		return err
+	}
+
+	endpoint := promptString(reader, "Endpoint URL", "https://api.openai.com")
+	if err := cmd.Flags().Set("endpoint-url", endpoint); err != nil {
+		return err
+	}
+
+	apiPathVal := promptString(reader, "API path", "/v1/embeddings")
+	if err := cmd.Flags().Set("api-path", apiPathVal); err != nil {
+		return err
+	}
+
+	fmt.Println("\nKnown models: text-embedding-3-small (1536), text-embedding-3-large (3072), text-embedding-ada-002 (1536)")
+	model := promptString(reader, "Model identifier", "text-embedding-3-small")
+	if err := cmd.Flags().Set("model-identifier", model); err != nil {
+		return err
+	}
+
+	dims := int32(0)
+	if known, ok := openAIModelDefaults[model]; ok {
+		dims = known
+		fmt.Printf("Using known dimensionality for %s: %d\n", model, dims)
+	} else {
+		dimsStr := promptString(reader, "Dimensionality", "")
+		parsed, err := strconv.Atoi(dimsStr)
+		if err != nil {
+			return fmt.Errorf("invalid dimensionality: %w", err)
+		}
+		dims = int32(parsed)
+	}
+	if err := cmd.Flags().Set("dimensionality", strconv.Itoa(int(dims))); err != nil {
+		return err
+	}
+
+	return wizardCommonFields(cmd, reader)
+}
+
+func wizardVLLM(cmd *cobra.Command, reader *bufio.Scanner) error {
+	if err := cmd.Flags().Set("provider-type", "VLLM"); err != nil {
+		return err
+	}
+
+	endpoint := promptString(reader, "vLLM host (e.g. http://localhost:8000)", "")
+	if endpoint == "" {
+		return fmt.Errorf("endpoint URL is required")
+	}
+	if err := cmd.Flags().Set("endpoint-url", endpoint); err != nil {
+		return err
+	}
+
+	apiPathVal := promptString(reader, "API path", "/v1/embeddings")
+	if err := cmd.Flags().Set("api-path", apiPathVal); err != nil {
+		return err
+	}
+
+	model := ""
+	if models, err := wizardListVLLMModels(endpoint); err != nil {
+		fmt.Printf("Could not auto-discover models from %s/v1/models: %v\n", endpoint, err)
+		model = promptString(reader, "Model identifier", "")
+	} else if len(models) > 0 {
+		fmt.Println("\nAvailable models:")
+		for i, m := range models {
+			fmt.Printf("  %d) %s\n", i+1, m)
+		}
+		choice := promptString(reader, "Choice (number or name)", "1")
+		if idx, err := strconv.Atoi(choice); err == nil && idx >= 1 && idx <= len(models) {
+			model = models[idx-1]
+		} else {
+			model = choice
+		}
+	} else {
+		model = promptString(reader, "Model identifier", "")
+	}
+	if model == "" {
+		return fmt.Errorf("model identifier is required")
+	}
+	if err := cmd.Flags().Set("model-identifier", model); err != nil {
+		return err
+	}
+
+	dims, err := wizardDetectDimensionality(endpoint, apiPathVal, model)
+	if err != nil {
+		fmt.Printf("Could not auto-detect dimensionality: %v\n", err)
+		dimsStr := promptString(reader, "Dimensionality", "")
+		parsed, err := strconv.Atoi(dimsStr)
+		if err != nil {
+			return fmt.Errorf("invalid dimensionality: %w", err)
+		}
+		dims = int32(parsed)
+	} else {
+		fmt.Printf("Detected dimensionality: %d\n", dims)
+	}
+	if err := cmd.Flags().Set("dimensionality", strconv.Itoa(int(dims))); err != nil {
+		return err
+	}
+
+	return wizardCommonFields(cmd, reader)
+}
+
+func wizardTEI(cmd *cobra.Command, reader *bufio.Scanner) error {
+	if err := cmd.Flags().Set("provider-type", "TEI"); err != nil {
+		return err
+	}
+
+	endpoint := promptString(reader, "TEI host (e.g. http://localhost:8080)", "")
+	if endpoint == "" {
+		return fmt.Errorf("endpoint URL is required")
+	}
+	if err := cmd.Flags().Set("endpoint-url", endpoint); err != nil {
+		return err
+	}
+
+	apiPathVal := promptString(reader, "API path", "/embed")
+	if err := cmd.Flags().Set("api-path", apiPathVal); err != nil {
+		return err
+	}
+
+	model := promptString(reader, "Model identifier", "")
+
+	maxSeqLen, info, err := wizardProbeTEIInfo(endpoint)
+	if err != nil {
+		fmt.Printf("Could not probe %s/info: %v\n", endpoint, err)
+	} else {
+		if maxSeqLen > 0 {
+			fmt.Printf("Detected max_input_length: %d\n", maxSeqLen)
+			if err := cmd.Flags().Set("max-sequence-length", strconv.Itoa(int(maxSeqLen))); err != nil {
+				return err
+			}
+		}
+		if model == "" && info != "" {
+			model = info
+			fmt.Printf("Using model_id from /info: %s\n", model)
+		}
+	}
+	if model == "" {
+		return fmt.Errorf("model identifier is required")
+	}
+	if err := cmd.Flags().Set("model-identifier", model); err != nil {
+		return err
+	}
+
+	dims, err := wizardDetectDimensionality(endpoint, apiPathVal, model)
+	if err != nil {
+		fmt.Printf("Could not auto-detect dimensionality: %v\n", err)
+		dimsStr := promptString(reader, "Dimensionality", "")
+		parsed, err := strconv.Atoi(dimsStr)
+		if err != nil {
+			return fmt.Errorf("invalid dimensionality: %w", err)
+		}
+		dims = int32(parsed)
+	} else {
+		fmt.Printf("Detected dimensionality: %d\n", dims)
+	}
+	if err := cmd.Flags().Set("dimensionality", strconv.Itoa(int(dims))); err != nil {
+		return err
+	}
+
+	return wizardCommonFields(cmd, reader)
+}
+
+// wizardListVLLMModels probes a vLLM server's OpenAI-compatible /v1/models
+// endpoint to enumerate loaded models.
+func wizardListVLLMModels(endpoint string) ([]string, error) {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	resp, err := httpClient.Get(strings.TrimSuffix(endpoint, "/") + "/v1/models")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+
+	var parsed struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+
+	models := make([]string, 0, len(parsed.Data))
+	for _, m := range parsed.Data {
+		models = append(models, m.ID)
+	}
+	return models, nil
+}
+
+// wizardProbeTEIInfo probes a TEI server's /info endpoint for
+// max_input_length and model_id.
+func wizardProbeTEIInfo(endpoint string) (int32, string, error) {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	resp, err := httpClient.Get(strings.TrimSuffix(endpoint, "/") + "/info")
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, "", fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+
+	var parsed struct {
+		MaxInputLength int32  `json:"max_input_length"`
+		ModelID        string `json:"model_id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return 0, "", err
+	}
+	return parsed.MaxInputLength, parsed.ModelID, nil
+}
+
+// wizardDetectDimensionality issues a minimal embedding request and returns
+// the length of the resulting vector.
+func wizardDetectDimensionality(endpoint, apiPath, model string) (int32, error) {
+	url := strings.TrimSuffix(endpoint, "/") + "/" + strings.TrimPrefix(apiPath, "/")
+
+	body, err := json.Marshal(map[string]interface{}{
+		"model": model,
+		"input": "dimensionality probe",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	resp, err := httpClient.Post(url, "application/json", strings.NewReader(string(body)))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+
+	var parsed struct {
+		Data []struct {
+			Embedding []float64 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return 0, err
+	}
+	if len(parsed.Data) == 0 {
+		return 0, fmt.Errorf("response contained no embeddings")
+	}
+	return int32(len(parsed.Data[0].Embedding)), nil
+}
+
+// wizardCommonFields prompts for the fields shared by every provider:
+// credentials and description.
+func wizardCommonFields(cmd *cobra.Command, reader *bufio.Scanner) error {
+	credentialsRefVal := promptString(reader, "Credentials ref (env:VAR, file:/path, vault:..., leave blank to type a literal value)", "")
+	if credentialsRefVal != "" {
+		if err := cmd.Flags().Set("credentials-ref", credentialsRefVal); err != nil {
+			return err
+		}
+	} else {
+		credentialsVal := promptString(reader, "Credentials", "")
+		if credentialsVal != "" {
+			if err := cmd.Flags().Set("credentials", credentialsVal); err != nil {
+				return err
+			}
+		}
+	}
+
+	description := promptString(reader, "Description (optional)", "")
+	if description != "" {
+		if err := cmd.Flags().Set("description", description); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// promptString prints a prompt (with its default, if any) and returns the
+// trimmed line the user typed, or the default if they just pressed Enter.
+func promptString(reader *bufio.Scanner, label, defaultValue string) string {
+	if defaultValue != "" {
+		fmt.Printf("%s [%s]: ", label, defaultValue)
+	} else {
+		fmt.Printf("%s: ", label)
+	}
+
+	if !reader.Scan() {
+		return defaultValue
+	}
+	line := strings.TrimSpace(reader.Text())
+	if line == "" {
+		return defaultValue
+	}
+	return line
+}
@@ -22,6 +22,15 @@ type ConfigFile struct {
 	UserId        string    `json:"user_id"`
 	Initialized   bool      `json:"initialized"`
 	InitializedAt time.Time `json:"initialized_at"`
+
+	// Persisted by `goodmem auth login`; AuthMode is one of "apikey" (default),
+	// "oidc", or "basic". OIDCRefreshToken is stored encrypted at rest (see
+	// loadOrCreateEncryptionKey in auth.go).
+	AuthMode         string   `json:"auth_mode,omitempty"`
+	OIDCIssuer       string   `json:"oidc_issuer,omitempty"`
+	OIDCClientID     string   `json:"oidc_client_id,omitempty"`
+	OIDCScopes       []string `json:"oidc_scopes,omitempty"`
+	OIDCRefreshToken string   `json:"oidc_refresh_token,omitempty"`
 }
 
 var (
@@ -92,20 +101,20 @@ The init command will:
 		}
 
 		// Make the init request to the server using gRPC
-		fmt.Printf("Connecting to gRPC API at %s\n", serverAddress)
-		
+		logger.Info("connecting to gRPC API", "server", serverAddress)
+
 		// Create HTTP client with proper HTTP/2 configuration for gRPC
 		httpClient := createHTTPClient(insecure, serverAddress)
-		
+
 		if insecure || (len(serverAddress) >= 5 && serverAddress[:5] == "https") {
-			fmt.Println("Using TLS with certificate verification disabled (insecure mode)")
+			logger.Warn("TLS certificate verification disabled (insecure mode)")
 		}
-		
+
 		// Create user service client with gRPC protocol
 		userClient := v1connect.NewUserServiceClient(
 			httpClient, 
 			serverAddress, 
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 		
 		// Create the initialization request
@@ -163,7 +172,7 @@ The init command will:
 				// Set permissions to user-only read/write
 				err = os.Chmod(configFile, 0600)
 				if err != nil {
-					fmt.Printf("Warning: Could not set secure permissions on config file: %v\n", err)
+					logger.Warn("could not set secure permissions on config file", "error", err)
 				}
 			}
 		}
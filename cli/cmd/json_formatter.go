@@ -168,4 +168,123 @@ func ConvertProtoTimestampToISO8601(ts *timestamppb.Timestamp) string {
 		return ""
 	}
 	return time.Unix(ts.Seconds, int64(ts.Nanos)).UTC().Format(time.RFC3339)
+}
+
+// jsonToProtoMessage is the inverse of formatProtoMessageAsJSON: it takes the
+// same REST-friendly JSON (camelCase keys, plain string UUIDs, ISO 8601
+// timestamps) and decodes it into a proto message, re-encoding UUIDs to
+// base64 and timestamps to {seconds,nanos} along the way so protojson can
+// take over from there.
+func jsonToProtoMessage(data []byte, msg proto.Message) error {
+	var jsonMap map[string]interface{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &jsonMap); err != nil {
+			return fmt.Errorf("error parsing request JSON: %w", err)
+		}
+	}
+
+	unprocessJSONMap(jsonMap)
+
+	protoJSON, err := json.Marshal(jsonMap)
+	if err != nil {
+		return fmt.Errorf("error re-encoding request JSON: %w", err)
+	}
+
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err := unmarshaler.Unmarshal(protoJSON, msg); err != nil {
+		return fmt.Errorf("error decoding request into %T: %w", msg, err)
+	}
+	return nil
+}
+
+// unprocessJSONMap walks a REST-friendly JSON map in place, reversing what
+// processJSONMap does: camelCase keys become snake_case, *_id string UUIDs
+// become base64, and *_at ISO 8601 strings become {seconds,nanos} timestamps.
+func unprocessJSONMap(v interface{}) {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		transformed := make(map[string]interface{})
+
+		for key, val := range m {
+			snakeKey := camelToSnakeCase(key)
+
+			if isUUIDField(snakeKey) {
+				if strVal, ok := val.(string); ok {
+					if b64, err := convertUUIDToBase64(strVal); err == nil {
+						transformed[snakeKey] = b64
+						continue
+					}
+				}
+				transformed[snakeKey] = val
+			} else if strings.HasSuffix(snakeKey, "_at") {
+				if strVal, ok := val.(string); ok {
+					if t, err := time.Parse(time.RFC3339, strVal); err == nil {
+						transformed[snakeKey] = map[string]interface{}{
+							"seconds": t.Unix(),
+							"nanos":   t.Nanosecond(),
+						}
+						continue
+					}
+				}
+				transformed[snakeKey] = val
+			} else {
+				unprocessJSONMap(val)
+				transformed[snakeKey] = val
+			}
+		}
+
+		for k := range m {
+			delete(m, k)
+		}
+		for k, v := range transformed {
+			m[k] = v
+		}
+
+	case []interface{}:
+		for i := range m {
+			unprocessJSONMap(m[i])
+		}
+	}
+}
+
+// camelToSnakeCase converts a camelCase (or already-ID-normalized) string
+// back to the snake_case field names protojson expects.
+func camelToSnakeCase(camel string) string {
+	if camel == "nextToken" {
+		return "next_token"
+	}
+	if camel == "id" {
+		return "id"
+	}
+
+	// Undo the "Id" -> "ID" normalization applied by snakeToCamelCase.
+	camel = strings.ReplaceAll(camel, "ID", "Id")
+
+	var b strings.Builder
+	for i, r := range camel {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r - 'A' + 'a')
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// convertUUIDToBase64 converts a canonical string UUID to its base64
+// encoded binary representation, matching what protojson expects for a
+// `bytes` field.
+func convertUUIDToBase64(uuidStr string) (string, error) {
+	id, err := uuid.Parse(uuidStr)
+	if err != nil {
+		return "", err
+	}
+	binUUID, err := id.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(binUUID), nil
 }
\ No newline at end of file
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"log/syslog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logLevel  string
+	logFormat string
+	logOutput string
+
+	logger = slog.New(slog.NewTextHandler(os.Stderr, nil))
+)
+
+// initLogger builds the package-level logger from --log-level/--log-format/
+// --log-output. It runs once, before any subcommand, so every gRPC call
+// logged via loggingInterceptor goes to the configured sink. The
+// human-readable summaries subcommands print today (e.g. the API key printed
+// by `init`) stay on stdout untouched; this only governs diagnostic/event
+// logs.
+func initLogger() error {
+	level, err := parseLogLevel(logLevel)
+	if err != nil {
+		return err
+	}
+
+	writer, err := openLogSink(logOutput)
+	if err != nil {
+		return err
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch strings.ToLower(logFormat) {
+	case "json":
+		handler = slog.NewJSONHandler(writer, opts)
+	case "text", "":
+		handler = slog.NewTextHandler(writer, opts)
+	default:
+		return fmt.Errorf("invalid log format: %s (should be 'text' or 'json')", logFormat)
+	}
+
+	logger = slog.New(handler)
+	return nil
+}
+
+func parseLogLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return 0, fmt.Errorf("invalid log level: %s (should be one of: debug, info, warn, error)", level)
+	}
+}
+
+// openLogSink resolves --log-output into an io.Writer: stderr (default), a
+// file (file:<path>), or syslog (syslog://host:port for remote, syslog:local
+// for the local daemon).
+func openLogSink(output string) (*os.File, error) {
+	switch {
+	case output == "" || output == "stderr":
+		return os.Stderr, nil
+	case strings.HasPrefix(output, "file:"):
+		path := strings.TrimPrefix(output, "file:")
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("error opening log file %s: %w", path, err)
+		}
+		return f, nil
+	case output == "syslog:local" || strings.HasPrefix(output, "syslog://"):
+		return openSyslogSink(output)
+	default:
+		return nil, fmt.Errorf("invalid log output: %s (should be 'stderr', 'file:<path>', 'syslog:local', or 'syslog://host:port')", output)
+	}
+}
+
+// openSyslogSink dials the syslog daemon and returns the write end of an
+// os.Pipe, so it has the same *os.File type that openLogSink's other branches
+// return; a goroutine copies whatever is written to the pipe into the syslog
+// connection.
+func openSyslogSink(output string) (*os.File, error) {
+	var (
+		writer *syslog.Writer
+		err    error
+	)
+	if output == "syslog:local" {
+		writer, err = syslog.New(syslog.LOG_INFO|syslog.LOG_USER, "goodmem")
+	} else {
+		addr := strings.TrimPrefix(output, "syslog://")
+		writer, err = syslog.Dial("udp", addr, syslog.LOG_INFO|syslog.LOG_USER, "goodmem")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to syslog: %w", err)
+	}
+
+	// Pipe slog's writes into the syslog connection on a goroutine so we can
+	// hand back a plain *os.File-compatible pipe end.
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			n, err := r.Read(buf)
+			if n > 0 {
+				writer.Info(string(buf[:n]))
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return w, nil
+}
+
+// loggingInterceptor emits one structured event per gRPC call (method,
+// duration, status code, request id), independent of whatever the command
+// prints to stdout for the user.
+func loggingInterceptor() connect.UnaryInterceptorFunc {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			requestID := uuid.New().String()
+			req.Header().Set("x-request-id", requestID)
+
+			start := time.Now()
+			resp, err := next(ctx, req)
+			duration := time.Since(start)
+
+			code := connect.CodeOf(err)
+			attrs := []any{
+				"method", req.Spec().Procedure,
+				"duration_ms", duration.Milliseconds(),
+				"status", code.String(),
+				"request_id", requestID,
+			}
+			if err != nil {
+				logger.Error("grpc call failed", attrs...)
+			} else {
+				logger.Debug("grpc call completed", attrs...)
+			}
+			return resp, err
+		}
+	}
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "Log level: debug, info, warn, or error")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "text", "Log format: text or json")
+	rootCmd.PersistentFlags().StringVar(&logOutput, "log-output", "stderr", "Log output: stderr, file:<path>, syslog:local, or syslog://host:port")
+
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := initLogger(); err != nil {
+			return err
+		}
+		return applyContextDefaults(cmd)
+	}
+}
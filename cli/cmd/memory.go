@@ -40,7 +40,7 @@ var createMemoryCmd = &cobra.Command{
 		client := v1connect.NewMemoryServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse metadata from key=value format
@@ -60,8 +60,10 @@ var createMemoryCmd = &cobra.Command{
 			Metadata:           metadataMap,
 		})
 
-		// Add API key header
-		req.Header().Set("x-api-key", "test-key")
+		// Add authentication (apikey, oidc, or basic depending on configuration)
+		if err := applyAuth(req); err != nil {
+			return err
+		}
 
 		resp, err := client.CreateMemory(context.Background(), req)
 		if err != nil {
@@ -93,15 +95,17 @@ var getMemoryCmd = &cobra.Command{
 		client := v1connect.NewMemoryServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		req := connect.NewRequest(&v1.GetMemoryRequest{
 			MemoryId: []byte(memoryID),
 		})
 
-		// Add API key header
-		req.Header().Set("x-api-key", "test-key")
+		// Add authentication (apikey, oidc, or basic depending on configuration)
+		if err := applyAuth(req); err != nil {
+			return err
+		}
 
 		resp, err := client.GetMemory(context.Background(), req)
 		if err != nil {
@@ -134,15 +138,17 @@ var listMemoriesCmd = &cobra.Command{
 		client := v1connect.NewMemoryServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		req := connect.NewRequest(&v1.ListMemoriesRequest{
 			SpaceId: []byte(spaceID),
 		})
 
-		// Add API key header
-		req.Header().Set("x-api-key", "test-key")
+		// Add authentication (apikey, oidc, or basic depending on configuration)
+		if err := applyAuth(req); err != nil {
+			return err
+		}
 
 		resp, err := client.ListMemories(context.Background(), req)
 		if err != nil {
@@ -174,15 +180,17 @@ var deleteMemoryCmd = &cobra.Command{
 		client := v1connect.NewMemoryServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		req := connect.NewRequest(&v1.DeleteMemoryRequest{
 			MemoryId: []byte(memoryID),
 		})
 
-		// Add API key header
-		req.Header().Set("x-api-key", "test-key")
+		// Add authentication (apikey, oidc, or basic depending on configuration)
+		if err := applyAuth(req); err != nil {
+			return err
+		}
 
 		_, err := client.DeleteMemory(context.Background(), req)
 		if err != nil {
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+)
+
+var (
+	maxRetries     int
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+	retryUnsafe    bool
+)
+
+// connectClientOptions returns the connect.ClientOption set every v1connect
+// client should be constructed with, so the retry policy and structured
+// call logging apply uniformly across the apikey/space/embedder/memory/user
+// subcommands.
+func connectClientOptions() []connect.ClientOption {
+	return []connect.ClientOption{
+		connect.WithGRPC(),
+		connect.WithInterceptors(loggingInterceptor(), retryInterceptor()),
+	}
+}
+
+// retryInterceptor retries transient failures (Unavailable, DeadlineExceeded,
+// ResourceExhausted)
+// with jittered exponential backoff. It never retries Unauthenticated or
+// PermissionDenied, mirroring the 403-vs-500 distinction made by most gRPC
+// clients: an auth failure won't be fixed by trying again.
+//
+// Idempotent calls (Get*, List*, Delete*) are retried by default; anything
+// else (notably Create*, which would mint a duplicate resource) only retries
+// when the caller passes --retry-unsafe.
+func retryInterceptor() connect.UnaryInterceptorFunc {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if maxRetries <= 0 {
+				return next(ctx, req)
+			}
+			if !isRetrySafe(req.Spec().Procedure) {
+				return next(ctx, req)
+			}
+
+			delay := retryBaseDelay
+			var lastErr error
+			for attempt := 0; attempt <= maxRetries; attempt++ {
+				resp, err := next(ctx, req)
+				if err == nil {
+					return resp, nil
+				}
+				lastErr = err
+
+				code := connect.CodeOf(err)
+				if code == connect.CodeUnauthenticated || code == connect.CodePermissionDenied {
+					return nil, err
+				}
+				if !isRetryableCode(code) || attempt == maxRetries {
+					return nil, err
+				}
+
+				sleep := jitteredDelay(delay)
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(sleep):
+				}
+
+				delay *= 2
+				if delay > retryMaxDelay {
+					delay = retryMaxDelay
+				}
+			}
+			return nil, lastErr
+		}
+	}
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+// isRetrySafe reports whether a connect procedure (e.g.
+// "/goodmem.v1.ApiKeyService/CreateApiKey") is safe to retry without operator
+// opt-in: Get/List/Delete calls are naturally idempotent, everything else
+// (chiefly Create*) requires --retry-unsafe to avoid duplicating resources.
+func isRetrySafe(procedure string) bool {
+	method := procedure
+	if idx := strings.LastIndex(procedure, "/"); idx >= 0 {
+		method = procedure[idx+1:]
+	}
+
+	idempotent := strings.HasPrefix(method, "Get") ||
+		strings.HasPrefix(method, "List") ||
+		strings.HasPrefix(method, "Delete")
+
+	return idempotent || retryUnsafe
+}
+
+func isRetryableCode(code connect.Code) bool {
+	// Unavailable/DeadlineExceeded/ResourceExhausted cover transient network
+	// blips, slow servers, and rate limiting. connect-go maps the gRPC
+	// transport's underlying HTTP 502/503/504 responses to CodeUnavailable,
+	// so those are already handled here too.
+	return code == connect.CodeUnavailable ||
+		code == connect.CodeDeadlineExceeded ||
+		code == connect.CodeResourceExhausted
+}
+
+func jitteredDelay(base time.Duration) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(base)))
+}
+
+// defaultMaxRetries resolves the --max-retries default, preferring the
+// GOODMEM_MAX_RETRIES environment variable when --max-retries isn't passed
+// explicitly, so scripted/CI usage can tune retry behavior without a flag.
+func defaultMaxRetries() int {
+	if env := os.Getenv("GOODMEM_MAX_RETRIES"); env != "" {
+		if n, err := strconv.Atoi(env); err == nil {
+			return n
+		}
+	}
+	return 3
+}
+
+func init() {
+	rootCmd.PersistentFlags().IntVar(&maxRetries, "max-retries", defaultMaxRetries(), "Maximum number of retries for transient gRPC errors (0 disables retries); defaults to $GOODMEM_MAX_RETRIES if set")
+	rootCmd.PersistentFlags().DurationVar(&retryBaseDelay, "retry-base-delay", 500*time.Millisecond, "Initial backoff delay between retries")
+	rootCmd.PersistentFlags().DurationVar(&retryMaxDelay, "retry-max-delay", 10*time.Second, "Maximum backoff delay between retries")
+	rootCmd.PersistentFlags().BoolVar(&retryUnsafe, "retry-unsafe", false, "Also retry non-idempotent calls (e.g. Create*), risking duplicate resources")
+}
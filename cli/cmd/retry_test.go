@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+)
+
+func TestIsRetrySafe(t *testing.T) {
+	savedRetryUnsafe := retryUnsafe
+	defer func() { retryUnsafe = savedRetryUnsafe }()
+
+	tests := []struct {
+		name        string
+		procedure   string
+		retryUnsafe bool
+		want        bool
+	}{
+		{name: "get is always safe", procedure: "/goodmem.v1.ApiKeyService/GetApiKey", retryUnsafe: false, want: true},
+		{name: "list is always safe", procedure: "/goodmem.v1.EmbedderService/ListEmbedders", retryUnsafe: false, want: true},
+		{name: "delete is always safe", procedure: "/goodmem.v1.SpaceService/DeleteSpace", retryUnsafe: false, want: true},
+		{name: "create is unsafe by default", procedure: "/goodmem.v1.EmbedderService/CreateEmbedder", retryUnsafe: false, want: false},
+		{name: "create is safe with --retry-unsafe", procedure: "/goodmem.v1.EmbedderService/CreateEmbedder", retryUnsafe: true, want: true},
+		{name: "update is unsafe by default", procedure: "/goodmem.v1.EmbedderService/UpdateEmbedder", retryUnsafe: false, want: false},
+		{name: "procedure without a slash is treated as the method name", procedure: "GetApiKey", retryUnsafe: false, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryUnsafe = tt.retryUnsafe
+			if got := isRetrySafe(tt.procedure); got != tt.want {
+				t.Errorf("isRetrySafe(%q) with retryUnsafe=%v = %v, want %v", tt.procedure, tt.retryUnsafe, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryableCode(t *testing.T) {
+	tests := []struct {
+		code connect.Code
+		want bool
+	}{
+		{connect.CodeUnavailable, true},
+		{connect.CodeDeadlineExceeded, true},
+		{connect.CodeResourceExhausted, true},
+		{connect.CodeUnauthenticated, false},
+		{connect.CodePermissionDenied, false},
+		{connect.CodeInvalidArgument, false},
+		{connect.CodeNotFound, false},
+	}
+
+	for _, tt := range tests {
+		if got := isRetryableCode(tt.code); got != tt.want {
+			t.Errorf("isRetryableCode(%v) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultMaxRetries(t *testing.T) {
+	savedEnv, hadEnv := os.LookupEnv("GOODMEM_MAX_RETRIES")
+	defer func() {
+		if hadEnv {
+			os.Setenv("GOODMEM_MAX_RETRIES", savedEnv)
+		} else {
+			os.Unsetenv("GOODMEM_MAX_RETRIES")
+		}
+	}()
+
+	t.Run("falls back to 3 when unset", func(t *testing.T) {
+		os.Unsetenv("GOODMEM_MAX_RETRIES")
+		if got := defaultMaxRetries(); got != 3 {
+			t.Errorf("defaultMaxRetries() = %d, want 3", got)
+		}
+	})
+
+	t.Run("uses GOODMEM_MAX_RETRIES when set", func(t *testing.T) {
+		os.Setenv("GOODMEM_MAX_RETRIES", "7")
+		if got := defaultMaxRetries(); got != 7 {
+			t.Errorf("defaultMaxRetries() = %d, want 7", got)
+		}
+	})
+
+	t.Run("falls back to 3 on an unparseable value", func(t *testing.T) {
+		os.Setenv("GOODMEM_MAX_RETRIES", "not-a-number")
+		if got := defaultMaxRetries(); got != 3 {
+			t.Errorf("defaultMaxRetries() = %d, want 3", got)
+		}
+	})
+}
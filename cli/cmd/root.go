@@ -48,6 +48,26 @@ var gitCommit string
 // createHTTPClient creates an HTTP client with proper HTTP/2 configuration
 // This is critical for gRPC operations to work correctly
 func createHTTPClient(insecure bool, serverAddr string) *http.Client {
+    // If goodmemd is running, route through its Unix socket instead of
+    // opening a fresh HTTP/2 connection for this invocation.
+    if !noDaemon {
+        if sockPath, err := resolveDaemonSocketPath(daemonSocketPath); err == nil {
+            if _, err := os.Stat(sockPath); err == nil {
+                return daemonHTTPClient(sockPath)
+            }
+        }
+    }
+
+    return createUpstreamHTTPClient(insecure, serverAddr)
+}
+
+// createUpstreamHTTPClient builds a direct HTTP/2 client for serverAddr,
+// skipping the Unix-socket preference in createHTTPClient. The daemon itself
+// must use this: by the time daemonCmd.RunE builds its upstream proxy, it has
+// already bound and chmod'd its own socket at the same path createHTTPClient
+// checks, so createHTTPClient would otherwise hand the daemon a client that
+// dials itself instead of the real GoodMem server.
+func createUpstreamHTTPClient(insecure bool, serverAddr string) *http.Client {
     // Plain HTTP? -> use an h2c transport
     if strings.HasPrefix(serverAddr, "http://") {
         h2cTransport := &http2.Transport{
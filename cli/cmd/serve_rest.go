@@ -0,0 +1,405 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+	"github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	restListenAddr  string
+	restTLSCert     string
+	restTLSKey      string
+	restBearerToken string
+)
+
+// serveRestCmd fronts the gRPC API with a plain REST/JSON gateway, so
+// scripts, browsers, and language ecosystems without gRPC tooling can talk
+// to a GoodMem server through the CLI binary. It reuses
+// formatProtoMessageAsJSON/jsonToProtoMessage for the REST<->proto
+// translation and createHTTPClient for the upstream connection.
+var serveRestCmd = &cobra.Command{
+	Use:   "serve-rest",
+	Short: "Start a REST/JSON gateway in front of the gRPC API",
+	Long: `Starts a local HTTP server that translates REST calls (e.g. GET
+/v1/spaces/{id}/memories, POST /v1/memories) into gRPC calls against the
+configured GoodMem server.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("ok"))
+		})
+		mux.HandleFunc("/v1/spaces", restCollectionHandler("space"))
+		mux.HandleFunc("/v1/spaces/", restSpaceItemHandler)
+		mux.HandleFunc("/v1/memories", restCollectionHandler("memory"))
+		mux.HandleFunc("/v1/embedders", restCollectionHandler("embedder"))
+		mux.HandleFunc("/v1/embedders/", restItemHandler("embedder"))
+		mux.HandleFunc("/v1/apikeys", restCollectionHandler("apikey"))
+
+		var handler http.Handler = mux
+		if restBearerToken != "" {
+			handler = requireBearerToken(restBearerToken, handler)
+		}
+
+		if restTLSCert != "" || restTLSKey != "" {
+			fmt.Printf("REST gateway listening on https://%s\n", restListenAddr)
+			return http.ListenAndServeTLS(restListenAddr, restTLSCert, restTLSKey, handler)
+		}
+
+		fmt.Printf("REST gateway listening on http://%s\n", restListenAddr)
+		return http.ListenAndServe(restListenAddr, h2c.NewHandler(handler, &http2.Server{}))
+	},
+}
+
+// requireBearerToken guards the gateway itself with a static bearer token,
+// independent of whatever credentials it uses against the upstream server.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// restCollectionHandler handles the non-parameterized collection endpoints,
+// e.g. GET/POST /v1/spaces.
+func restCollectionHandler(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			restList(w, r, resource)
+		case http.MethodPost:
+			restCreate(w, r, resource)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// restItemHandler handles /v1/{resource}/{id} for resources with no nested
+// sub-collections.
+func restItemHandler(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/v1/%ss/", resource))
+		switch r.Method {
+		case http.MethodGet:
+			restGet(w, r, resource, id)
+		case http.MethodDelete:
+			restDelete(w, r, resource, id)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// restSpaceItemHandler additionally supports GET /v1/spaces/{id}/memories.
+func restSpaceItemHandler(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/v1/spaces/")
+	if rest, ok := strings.CutSuffix(path, "/memories"); ok && r.Method == http.MethodGet {
+		restListMemoriesForSpace(w, r, rest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		restGet(w, r, "space", path)
+	case http.MethodDelete:
+		restDelete(w, r, "space", path)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func restListMemoriesForSpace(w http.ResponseWriter, r *http.Request, spaceIDStr string) {
+	spaceID, err := uuidStringToBytes(spaceIDStr)
+	if err != nil {
+		http.Error(w, "invalid space id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpClient := createHTTPClient(true, serverAddress)
+	client := v1connect.NewMemoryServiceClient(httpClient, serverAddress, connectClientOptions()...)
+
+	req := connect.NewRequest(&v1.ListMemoriesRequest{SpaceId: spaceID})
+	if err := addAuthHeader(req); err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+
+	resp, err := client.ListMemories(context.Background(), req)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	writeProtoJSON(w, resp.Msg)
+}
+
+func restList(w http.ResponseWriter, r *http.Request, resource string) {
+	httpClient := createHTTPClient(true, serverAddress)
+
+	var (
+		msg proto.Message
+		err error
+	)
+	switch resource {
+	case "space":
+		client := v1connect.NewSpaceServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(&v1.ListSpacesRequest{})
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.ListSpacesResponse]
+			resp, err = client.ListSpaces(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	case "embedder":
+		client := v1connect.NewEmbedderServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(&v1.ListEmbeddersRequest{})
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.ListEmbeddersResponse]
+			resp, err = client.ListEmbedders(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	case "apikey":
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(&v1.ListApiKeysRequest{})
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.ListApiKeysResponse]
+			resp, err = client.ListApiKeys(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	default:
+		http.Error(w, "unsupported resource: "+resource, http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	writeProtoJSON(w, msg)
+}
+
+func restGet(w http.ResponseWriter, r *http.Request, resource, idStr string) {
+	id, err := uuidStringToBytes(idStr)
+	if err != nil {
+		http.Error(w, "invalid id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpClient := createHTTPClient(true, serverAddress)
+
+	var msg proto.Message
+	switch resource {
+	case "space":
+		client := v1connect.NewSpaceServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(&v1.GetSpaceRequest{SpaceId: id})
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.Space]
+			resp, err = client.GetSpace(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	case "embedder":
+		client := v1connect.NewEmbedderServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(&v1.GetEmbedderRequest{EmbedderId: id})
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.Embedder]
+			resp, err = client.GetEmbedder(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	default:
+		http.Error(w, "unsupported resource: "+resource, http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	writeProtoJSON(w, msg)
+}
+
+func restDelete(w http.ResponseWriter, r *http.Request, resource, idStr string) {
+	id, err := uuidStringToBytes(idStr)
+	if err != nil {
+		http.Error(w, "invalid id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpClient := createHTTPClient(true, serverAddress)
+
+	switch resource {
+	case "space":
+		client := v1connect.NewSpaceServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(&v1.DeleteSpaceRequest{SpaceId: id})
+		if err = addAuthHeader(req); err == nil {
+			_, err = client.DeleteSpace(context.Background(), req)
+		}
+	case "embedder":
+		client := v1connect.NewEmbedderServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(&v1.DeleteEmbedderRequest{EmbedderId: id})
+		if err = addAuthHeader(req); err == nil {
+			_, err = client.DeleteEmbedder(context.Background(), req)
+		}
+	default:
+		http.Error(w, "unsupported resource: "+resource, http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func restCreate(w http.ResponseWriter, r *http.Request, resource string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "error reading request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpClient := createHTTPClient(true, serverAddress)
+
+	var msg proto.Message
+	switch resource {
+	case "space":
+		createReq := &v1.CreateSpaceRequest{}
+		if err = jsonToProtoMessage(body, createReq); err != nil {
+			break
+		}
+		client := v1connect.NewSpaceServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(createReq)
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.Space]
+			resp, err = client.CreateSpace(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	case "memory":
+		createReq := &v1.CreateMemoryRequest{}
+		if err = jsonToProtoMessage(body, createReq); err != nil {
+			break
+		}
+		client := v1connect.NewMemoryServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(createReq)
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.Memory]
+			resp, err = client.CreateMemory(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	case "embedder":
+		createReq := &v1.CreateEmbedderRequest{}
+		if err = jsonToProtoMessage(body, createReq); err != nil {
+			break
+		}
+		client := v1connect.NewEmbedderServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(createReq)
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.Embedder]
+			resp, err = client.CreateEmbedder(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	case "apikey":
+		createReq := &v1.CreateApiKeyRequest{}
+		if err = jsonToProtoMessage(body, createReq); err != nil {
+			break
+		}
+		client := v1connect.NewApiKeyServiceClient(httpClient, serverAddress, connectClientOptions()...)
+		req := connect.NewRequest(createReq)
+		if err = addAuthHeader(req); err == nil {
+			var resp *connect.Response[v1.CreateApiKeyResponse]
+			resp, err = client.CreateApiKey(context.Background(), req)
+			if err == nil {
+				msg = resp.Msg
+			}
+		}
+	default:
+		http.Error(w, "unsupported resource: "+resource, http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	writeProtoJSON(w, msg)
+}
+
+func writeProtoJSON(w http.ResponseWriter, msg proto.Message) {
+	jsonBytes, err := formatProtoMessageAsJSON(msg)
+	if err != nil {
+		http.Error(w, "error formatting response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(jsonBytes)
+}
+
+func writeGatewayError(w http.ResponseWriter, err error) {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		http.Error(w, connectErr.Message(), connectCodeToHTTPStatus(connectErr.Code()))
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+func connectCodeToHTTPStatus(code connect.Code) int {
+	switch code {
+	case connect.CodeNotFound:
+		return http.StatusNotFound
+	case connect.CodeInvalidArgument:
+		return http.StatusBadRequest
+	case connect.CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case connect.CodePermissionDenied:
+		return http.StatusForbidden
+	case connect.CodeAlreadyExists:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(serveRestCmd)
+	serveRestCmd.Flags().StringVar(&restListenAddr, "listen", ":8080", "Address for the REST gateway to listen on")
+	serveRestCmd.Flags().StringVar(&restTLSCert, "tls-cert", "", "TLS certificate file (enables https:// instead of http://)")
+	serveRestCmd.Flags().StringVar(&restTLSKey, "tls-key", "", "TLS key file (required with --tls-cert)")
+	serveRestCmd.Flags().StringVar(&restBearerToken, "bearer-token", "", "Require this bearer token on incoming gateway requests")
+}
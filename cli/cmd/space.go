@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/bufbuild/connect-go"
@@ -35,6 +38,11 @@ var (
 
 	// Variables for updateSpaceCmd
 	labelUpdateStrategy string
+
+	// Variables for listSpacesCmd --watch
+	watchSpaces         bool
+	watchInterval        time.Duration
+	watchOnlyEvents      []string
 )
 
 // spaceCmd represents the space command
@@ -68,13 +76,11 @@ func parseLabels(labelSlice []string) (map[string]string, error) {
 	return labelsMap, nil
 }
 
-// addAuthHeader adds the API key authentication header to a connect request
+// addAuthHeader adds the configured authentication credentials to a connect
+// request. It defers to applyAuth so every subcommand picks up whichever
+// auth mode (apikey, oidc, basic) is currently configured.
 func addAuthHeader(req connect.AnyRequest) error {
-	if apiKey == "" {
-		return fmt.Errorf("API key is required. Set it using the --api-key flag or GOODMEM_API_KEY environment variable")
-	}
-	req.Header().Set("x-api-key", apiKey)
-	return nil
+	return applyAuth(req)
 }
 
 // createSpaceCmd represents the create command
@@ -107,7 +113,7 @@ var createSpaceCmd = &cobra.Command{
 		client := v1connect.NewSpaceServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse the labels
@@ -283,7 +289,7 @@ var listSpacesCmd = &cobra.Command{
 		client := v1connect.NewSpaceServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse labels from key=value format
@@ -333,6 +339,10 @@ var listSpacesCmd = &cobra.Command{
 			reqMsg.SortOrder = &protoSortOrder
 		}
 
+		if watchSpaces {
+			return watchListSpaces(cmd, client, reqMsg)
+		}
+
 		req := connect.NewRequest(reqMsg)
 
 		// Add API key header from global config
@@ -487,6 +497,124 @@ var listSpacesCmd = &cobra.Command{
 	},
 }
 
+// watchListSpaces implements `space list --watch`: it re-issues ListSpaces on
+// --watch-interval and renders only what changed since the last poll,
+// keyed by space UUID. There is no server-side WatchSpaces streaming RPC in
+// this client yet, so this is a client-side polling fallback; swapping in a
+// real stream later only needs a new branch here, not a change to the diff
+// logic below.
+func watchListSpaces(cmd *cobra.Command, client v1connect.SpaceServiceClient, reqMsg *v1.ListSpacesRequest) error {
+	allowedEvents := make(map[string]bool)
+	for _, e := range watchOnlyEvents {
+		allowedEvents[strings.TrimSpace(strings.ToLower(e))] = true
+	}
+	eventAllowed := func(event string) bool {
+		return len(allowedEvents) == 0 || allowedEvents[event]
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+
+	fmt.Printf("Watching spaces every %s (press Ctrl+C to stop)...\n", watchInterval)
+
+	last := make(map[string]*v1.Space)
+
+	for {
+		req := connect.NewRequest(reqMsg)
+		if err := addAuthHeader(req); err != nil {
+			return err
+		}
+
+		resp, err := client.ListSpaces(context.Background(), req)
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return fmt.Errorf("%v", connectErr.Message())
+			}
+			return fmt.Errorf("unexpected error: %w", err)
+		}
+
+		current := make(map[string]*v1.Space, len(resp.Msg.Spaces))
+		for _, space := range resp.Msg.Spaces {
+			spaceIDStr, err := uuidBytesToString(space.SpaceId)
+			if err != nil {
+				continue
+			}
+			current[spaceIDStr] = space
+		}
+
+		for id, space := range current {
+			oldSpace, existed := last[id]
+			switch {
+			case !existed:
+				renderSpaceEvent(cmd, "add", space, eventAllowed)
+			case !spacesEqualForWatch(oldSpace, space):
+				renderSpaceEvent(cmd, "update", space, eventAllowed)
+			}
+		}
+		for id, space := range last {
+			if _, stillPresent := current[id]; !stillPresent {
+				renderSpaceEvent(cmd, "remove", space, eventAllowed)
+			}
+		}
+
+		last = current
+
+		select {
+		case <-sigCh:
+			fmt.Println("\nStopping watch.")
+			return nil
+		case <-time.After(watchInterval):
+		}
+	}
+}
+
+// spacesEqualForWatch reports whether two observations of the same space are
+// equivalent for watch purposes, comparing the fields --watch would actually
+// expect to change (name, labels, visibility, update time).
+func spacesEqualForWatch(a, b *v1.Space) bool {
+	if a.Name != b.Name || a.PublicRead != b.PublicRead {
+		return false
+	}
+	if len(a.Labels) != len(b.Labels) {
+		return false
+	}
+	for k, v := range a.Labels {
+		if b.Labels[k] != v {
+			return false
+		}
+	}
+	aUpdated, bUpdated := formatTimestamp(a.UpdatedAt), formatTimestamp(b.UpdatedAt)
+	return aUpdated == bUpdated
+}
+
+// renderSpaceEvent prints a single watch diff event (add/update/remove) in
+// the format selected by --format, respecting --watch-only-events.
+func renderSpaceEvent(cmd *cobra.Command, event string, space *v1.Space, eventAllowed func(string) bool) {
+	if !eventAllowed(event) {
+		return
+	}
+
+	spaceIDStr, err := uuidBytesToString(space.SpaceId)
+	if err != nil {
+		spaceIDStr = fmt.Sprintf("<invalid-uuid:%x>", space.SpaceId)
+	}
+
+	if outputFormat == "json" {
+		jsonBytes, err := formatProtoMessageAsJSON(space)
+		if err != nil {
+			fmt.Printf("error formatting space as JSON: %v\n", err)
+			return
+		}
+		fmt.Printf("%s %s\n", strings.ToUpper(event), string(jsonBytes))
+		return
+	}
+
+	symbol := map[string]string{"add": "+", "update": "~", "remove": "-"}[event]
+	fmt.Printf("%s %s  %s\n", symbol, spaceIDStr, truncateString(space.Name, 30))
+}
+
 // deleteSpaceCmd represents the delete command
 var deleteSpaceCmd = &cobra.Command{
 	Use:   "delete [space-id]",
@@ -511,7 +639,7 @@ var deleteSpaceCmd = &cobra.Command{
 		client := v1connect.NewSpaceServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		req := connect.NewRequest(&v1.DeleteSpaceRequest{
@@ -568,7 +696,7 @@ var getSpaceCmd = &cobra.Command{
 		client := v1connect.NewSpaceServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		req := connect.NewRequest(&v1.GetSpaceRequest{
@@ -641,7 +769,7 @@ var updateSpaceCmd = &cobra.Command{
 		client := v1connect.NewSpaceServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Parse labels from key=value format
@@ -754,6 +882,9 @@ func init() {
 	listSpacesCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", "Output format (json, table, or compact)")
 	listSpacesCmd.Flags().BoolVar(&noTruncate, "no-trunc", false, "Do not truncate output values")
 	listSpacesCmd.Flags().BoolVarP(&quietOutput, "quiet", "q", false, "Output only space IDs")
+	listSpacesCmd.Flags().BoolVarP(&watchSpaces, "watch", "w", false, "Keep running and print only the spaces that changed since the last poll")
+	listSpacesCmd.Flags().DurationVar(&watchInterval, "watch-interval", 2*time.Second, "Polling interval in watch mode")
+	listSpacesCmd.Flags().StringSliceVar(&watchOnlyEvents, "watch-only-events", []string{}, "Restrict watch output to these event types: add, update, remove (default: all)")
 
 	// Flags for update
 	updateSpaceCmd.Flags().StringVar(&spaceName, "name", "", "New name for the space")
@@ -0,0 +1,379 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/pairsys/goodmem/cli/gen/goodmem/v1"
+	v1connect "github.com/pairsys/goodmem/cli/gen/goodmem/v1/v1connect"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	deleteManyFromFile   string
+	deleteManyParallelism int
+
+	applyFile    string
+	applyDryRun  bool
+	applyPrune   bool
+)
+
+// deleteManySpacesCmd represents the delete-many command
+var deleteManySpacesCmd = &cobra.Command{
+	Use:   "delete-many [space-id...]",
+	Short: "Delete many spaces concurrently",
+	Long: `Delete multiple spaces by ID, read from command-line arguments,
+--from-file (one UUID per line), or piped stdin if neither is given.
+Deletions run concurrently across a --parallelism-sized worker pool.`,
+	Example: `  # Delete spaces listed on the command line
+  goodmem space delete-many 123e4567-e89b-12d3-a456-426614174000 223e4567-e89b-12d3-a456-426614174001
+
+  # Delete spaces listed in a file, ten at a time
+  goodmem space delete-many --from-file spaces.txt --parallelism 10
+
+  # Delete spaces piped in from another command
+  goodmem space list --quiet | goodmem space delete-many`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		ids, err := collectSpaceIDs(args, deleteManyFromFile)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return fmt.Errorf("no space IDs provided (pass them as arguments, --from-file, or via stdin)")
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewSpaceServiceClient(
+			httpClient,
+			serverAddress,
+			connectClientOptions()...,
+		)
+
+		type result struct {
+			id  string
+			err error
+		}
+
+		jobs := make(chan string)
+		results := make(chan result, len(ids))
+
+		parallelism := deleteManyParallelism
+		if parallelism < 1 {
+			parallelism = 1
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < parallelism; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for id := range jobs {
+					results <- result{id: id, err: deleteOneSpace(client, id)}
+				}
+			}()
+		}
+
+		for _, id := range ids {
+			jobs <- id
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+
+		succeeded, failed := 0, 0
+		fmt.Printf("%-38s %-10s %s\n", "SPACE ID", "RESULT", "ERROR")
+		fmt.Println(strings.Repeat("-", 90))
+		for r := range results {
+			if r.err != nil {
+				failed++
+				fmt.Printf("%-38s %-10s %s\n", r.id, "FAILED", r.err)
+			} else {
+				succeeded++
+				fmt.Printf("%-38s %-10s\n", r.id, "OK")
+			}
+		}
+
+		fmt.Printf("\n%d succeeded, %d failed\n", succeeded, failed)
+		if failed > 0 {
+			return fmt.Errorf("%d of %d deletions failed", failed, len(ids))
+		}
+		return nil
+	},
+}
+
+func deleteOneSpace(client v1connect.SpaceServiceClient, idStr string) error {
+	id, err := uuidStringToBytes(idStr)
+	if err != nil {
+		return fmt.Errorf("invalid space ID: %w", err)
+	}
+
+	req := connect.NewRequest(&v1.DeleteSpaceRequest{SpaceId: id})
+	if err := addAuthHeader(req); err != nil {
+		return err
+	}
+
+	if _, err := client.DeleteSpace(context.Background(), req); err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return fmt.Errorf("%s: %v", connectErr.Code(), connectErr.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// collectSpaceIDs resolves the space IDs to operate on from, in priority
+// order, command-line arguments, --from-file, or stdin (one UUID per line,
+// blank lines and '#' comments ignored).
+func collectSpaceIDs(args []string, fromFile string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	if fromFile != "" {
+		f, err := os.Open(fromFile)
+		if err != nil {
+			return nil, fmt.Errorf("error opening %s: %w", fromFile, err)
+		}
+		defer f.Close()
+		return scanSpaceIDs(f), nil
+	}
+
+	stat, err := os.Stdin.Stat()
+	if err == nil && (stat.Mode()&os.ModeCharDevice) == 0 {
+		return scanSpaceIDs(os.Stdin), nil
+	}
+
+	return nil, nil
+}
+
+func scanSpaceIDs(r *os.File) []string {
+	var ids []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ids = append(ids, line)
+	}
+	return ids
+}
+
+// spaceManifestEntry is one entry of the declarative document read by
+// `space apply -f`.
+type spaceManifestEntry struct {
+	Name       string            `yaml:"name" json:"name"`
+	EmbedderID string            `yaml:"embedder_id" json:"embedder_id"`
+	Labels     map[string]string `yaml:"labels" json:"labels"`
+	PublicRead bool              `yaml:"public_read" json:"public_read"`
+	Owner      string            `yaml:"owner" json:"owner"`
+}
+
+// applySpacesCmd represents the apply command
+var applySpacesCmd = &cobra.Command{
+	Use:   "apply -f <file>",
+	Short: "Converge spaces to match a declarative manifest",
+	Long: `Reads a YAML or JSON document listing the desired spaces (name,
+embedder_id, labels, public_read, owner), diffs it against the spaces that
+already exist (matched by name+owner), and issues Create/Update calls to
+converge state. Pass --prune to also delete spaces that exist on the server
+but are absent from the manifest.`,
+	Example: `  # Preview what would change
+  goodmem space apply -f spaces.yaml --dry-run
+
+  # Apply the manifest, deleting spaces no longer listed
+  goodmem space apply -f spaces.yaml --prune`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		if applyFile == "" {
+			return fmt.Errorf("-f/--file is required")
+		}
+
+		desired, err := readSpaceManifest(applyFile)
+		if err != nil {
+			return err
+		}
+
+		httpClient := createHTTPClient(true, serverAddress)
+		client := v1connect.NewSpaceServiceClient(
+			httpClient,
+			serverAddress,
+			connectClientOptions()...,
+		)
+
+		listReq := connect.NewRequest(&v1.ListSpacesRequest{})
+		if err := addAuthHeader(listReq); err != nil {
+			return err
+		}
+		listResp, err := client.ListSpaces(context.Background(), listReq)
+		if err != nil {
+			return unwrapConnectError(err)
+		}
+
+		existingByKey := make(map[string]*v1.Space)
+		for _, space := range listResp.Msg.Spaces {
+			ownerIDStr, _ := uuidBytesToString(space.OwnerId)
+			existingByKey[space.Name+"|"+ownerIDStr] = space
+		}
+
+		seenKeys := make(map[string]bool)
+		var created, updated, deleted int
+
+		for _, entry := range desired {
+			key := entry.Name + "|" + entry.Owner
+			seenKeys[key] = true
+
+			if existing, ok := existingByKey[key]; ok {
+				if spaceMatchesManifest(existing, entry) {
+					continue
+				}
+				fmt.Printf("update: %s\n", entry.Name)
+				updated++
+				if applyDryRun {
+					continue
+				}
+				if err := updateSpaceFromManifest(client, existing, entry); err != nil {
+					return fmt.Errorf("error updating space %q: %w", entry.Name, err)
+				}
+			} else {
+				fmt.Printf("create: %s\n", entry.Name)
+				created++
+				if applyDryRun {
+					continue
+				}
+				if err := createSpaceFromManifest(client, entry); err != nil {
+					return fmt.Errorf("error creating space %q: %w", entry.Name, err)
+				}
+			}
+		}
+
+		if applyPrune {
+			for key, space := range existingByKey {
+				if seenKeys[key] {
+					continue
+				}
+				fmt.Printf("delete: %s\n", space.Name)
+				deleted++
+				if applyDryRun {
+					continue
+				}
+				if err := deleteOneSpace(client, formatUUID(space.SpaceId)); err != nil {
+					return fmt.Errorf("error deleting space %q: %w", space.Name, err)
+				}
+			}
+		}
+
+		if applyDryRun {
+			fmt.Printf("\nDry run: %d to create, %d to update, %d to delete\n", created, updated, deleted)
+		} else {
+			fmt.Printf("\n%d created, %d updated, %d deleted\n", created, updated, deleted)
+		}
+		return nil
+	},
+}
+
+func spaceMatchesManifest(existing *v1.Space, entry spaceManifestEntry) bool {
+	if existing.PublicRead != entry.PublicRead {
+		return false
+	}
+	if len(existing.Labels) != len(entry.Labels) {
+		return false
+	}
+	for k, v := range entry.Labels {
+		if existing.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func createSpaceFromManifest(client v1connect.SpaceServiceClient, entry spaceManifestEntry) error {
+	embedderID, err := uuidStringToBytes(entry.EmbedderID)
+	if err != nil {
+		return fmt.Errorf("invalid embedder_id: %w", err)
+	}
+
+	createReq := &v1.CreateSpaceRequest{
+		Name:       entry.Name,
+		Labels:     entry.Labels,
+		PublicRead: entry.PublicRead,
+		EmbedderId: embedderID,
+	}
+	if entry.Owner != "" {
+		ownerID, err := uuidStringToBytes(entry.Owner)
+		if err != nil {
+			return fmt.Errorf("invalid owner: %w", err)
+		}
+		createReq.OwnerId = ownerID
+	}
+
+	req := connect.NewRequest(createReq)
+	if err := addAuthHeader(req); err != nil {
+		return err
+	}
+	_, err = client.CreateSpace(context.Background(), req)
+	if err != nil {
+		return unwrapConnectError(err)
+	}
+	return nil
+}
+
+func updateSpaceFromManifest(client v1connect.SpaceServiceClient, existing *v1.Space, entry spaceManifestEntry) error {
+	publicRead := entry.PublicRead
+	updateReq := &v1.UpdateSpaceRequest{
+		SpaceId:    existing.SpaceId,
+		PublicRead: &publicRead,
+		LabelUpdateStrategy: &v1.UpdateSpaceRequest_ReplaceLabels{
+			ReplaceLabels: &v1.StringMap{Labels: entry.Labels},
+		},
+	}
+
+	req := connect.NewRequest(updateReq)
+	if err := addAuthHeader(req); err != nil {
+		return err
+	}
+	_, err := client.UpdateSpace(context.Background(), req)
+	if err != nil {
+		return unwrapConnectError(err)
+	}
+	return nil
+}
+
+// readSpaceManifest loads a space manifest from disk, parsing it with the
+// YAML decoder only; JSON manifests are accepted because a JSON document is
+// also valid YAML.
+func readSpaceManifest(path string) ([]spaceManifestEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	var entries []spaceManifestEntry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+func init() {
+	spaceCmd.AddCommand(deleteManySpacesCmd)
+	spaceCmd.AddCommand(applySpacesCmd)
+
+	deleteManySpacesCmd.Flags().StringVar(&deleteManyFromFile, "from-file", "", "Read space IDs from a file, one UUID per line")
+	deleteManySpacesCmd.Flags().IntVar(&deleteManyParallelism, "parallelism", 4, "Number of concurrent delete workers")
+
+	applySpacesCmd.Flags().StringVarP(&applyFile, "file", "f", "", "Path to a YAML or JSON manifest of the desired spaces (required)")
+	applySpacesCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "Print the actions that would be taken without making any changes")
+	applySpacesCmd.Flags().BoolVar(&applyPrune, "prune", false, "Delete spaces present on the server but absent from the manifest")
+}
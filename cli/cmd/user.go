@@ -43,7 +43,7 @@ Can be called with either a user ID or email address to look up a specific user.
 		client := v1connect.NewUserServiceClient(
 			httpClient,
 			serverAddress,
-			connect.WithGRPC(),
+			connectClientOptions()...,
 		)
 
 		// Initialize the request
@@ -63,11 +63,8 @@ Can be called with either a user ID or email address to look up a specific user.
 		// Create the request
 		req := connect.NewRequest(request)
 
-		// Add API key header from global config
-		if apiKey != "" {
-			req.Header().Set("x-api-key", apiKey)
-		} else {
-			return fmt.Errorf("API key is required. Set it using the --api-key flag or GOODMEM_API_KEY environment variable")
+		if err := addAuthHeader(req); err != nil {
+			return err
 		}
 
 		resp, err := client.GetUser(context.Background(), req)
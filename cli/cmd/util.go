@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -63,6 +64,12 @@ func truncateString(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// uuidBytesEqual reports whether two binary UUIDs (protobuf bytes fields)
+// refer to the same UUID.
+func uuidBytesEqual(a, b []byte) bool {
+	return bytes.Equal(a, b)
+}
+
 // formatUUID formats binary UUID bytes to a string
 func formatUUID(uuidBytes []byte) string {
 	if len(uuidBytes) != 16 {